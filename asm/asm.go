@@ -0,0 +1,469 @@
+//Package asm assembles CHIP-8 programs written in the Cowgod style syntax
+//the disassembler prints, so its listings assemble back into the same ROM.
+//
+//A source file has one statement per line, and ; starts a comment:
+//	name:                label the next address, optionally followed by a statement
+//	NAME = expr          define a constant, from numbers and names defined above it
+//	LD V0, 0x20          an instruction, see vm.Mnemonics for the whole set
+//	byte 1, 2, 0x3F      bytes, also spelled db
+//	word 0x1234, label   big endian words, also spelled dw
+//	sprite ..####..      sprite rows of 8 or 16 pixels, # X or 1 set and . _ or 0 clear
+//	include "file.asm"   assemble another file here, by its path relative to this one
+//	org 0x300            continue at a later address, padding with zeros
+//
+//Mnemonics, directives and registers are case insensitive, names are not.
+//Expressions combine numbers (12, 0x1F, 0b101) and names with
+//( ) - * / + - << >> & ^ |, binding like in Go.
+//The long address of XO-CHIP's F000 NNNN is written LD I, long addr.
+package asm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/makoto126/term-atari/vm"
+)
+
+const (
+	//Base is the address programs are assembled for
+	Base = 0x200
+	//maxInclude bounds the nesting of includes, catching files that include themselves
+	maxInclude = 16
+)
+
+type (
+	//Pos is a position in a source file, counting lines and columns from 1
+	Pos struct {
+		File string
+		Line int
+		Col  int
+	}
+
+	//Error is a problem found at a position of the source
+	Error struct {
+		Pos Pos
+		Msg string
+	}
+
+	//ErrorList is every problem found in a source, in order
+	ErrorList []*Error
+)
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Col)
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Msg)
+}
+
+func (l ErrorList) Error() string {
+
+	msgs := make([]string, len(l))
+	for i, e := range l {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+type (
+	//template is an instruction of the vm table, ops being its operand patterns
+	template struct {
+		key uint16
+		ops []string
+	}
+
+	//stmt is a statement that emits bytes, laid out at addr by the first pass
+	stmt struct {
+		pos  Pos
+		text string
+		addr int
+		op   string
+		tmpl *template
+		args [][]token
+		data []byte
+	}
+
+	assembler struct {
+		open    func(string) ([]byte, error)
+		symbols map[string]int
+		stmts   []stmt
+		addr    int
+		errs    ErrorList
+		//files lists the files read, in the order they were
+		files []string
+	}
+)
+
+var (
+	//templates are the instruction templates by mnemonic
+	templates = make(map[string][]template)
+	//keywords are the fixed operands of the templates, such as DT and [I]
+	keywords = make(map[string]bool)
+)
+
+func init() {
+
+	fields := map[string]bool{"Vx": true, "Vy": true, "addr": true, "byte": true, "nibble": true, "long": true, "plane": true}
+	for key, asm := range vm.Mnemonics() {
+		words := strings.SplitN(asm, " ", 2)
+		t := template{key: key}
+		if len(words) == 2 {
+			for _, op := range strings.Split(words[1], ",") {
+				op = strings.TrimSpace(op)
+				t.ops = append(t.ops, op)
+				if !fields[op] {
+					keywords[op] = true
+				}
+			}
+		}
+		templates[words[0]] = append(templates[words[0]], t)
+	}
+	for _, ts := range templates {
+		sort.Slice(ts, func(i, j int) bool { return ts[i].key < ts[j].key })
+	}
+}
+
+//Assemble assembles the source of the named file into a ROM to load at Base,
+//along with the source line of each instruction.
+//Included files are read with open. Errors are an ErrorList.
+func Assemble(name string, src []byte, open func(string) ([]byte, error)) ([]byte, vm.SourceMap, error) {
+
+	a := &assembler{
+		open:    open,
+		symbols: make(map[string]int),
+		addr:    Base,
+	}
+	a.file(name, src, 0)
+
+	rom := make([]byte, a.addr-Base)
+	lines := make(vm.SourceMap)
+	for _, s := range a.stmts {
+		copy(rom[s.addr-Base:], a.emit(s))
+		if s.tmpl != nil {
+			lines[uint16(s.addr)] = vm.SourceLine{File: s.pos.File, Line: s.pos.Line, Text: s.text}
+		}
+	}
+	if a.errs != nil {
+		a.sortErrors()
+		return nil, nil, a.errs
+	}
+	return rom, lines, nil
+}
+
+//sortErrors puts the errors in source order, as the passes find them out of it:
+//by file in the order they were read, then by line and column
+func (a *assembler) sortErrors() {
+
+	rank := make(map[string]int)
+	for i, f := range a.files {
+		if _, ok := rank[f]; !ok {
+			rank[f] = i
+		}
+	}
+	sort.SliceStable(a.errs, func(i, j int) bool {
+		p, q := a.errs[i].Pos, a.errs[j].Pos
+		if p.File != q.File {
+			return rank[p.File] < rank[q.File]
+		}
+		if p.Line != q.Line {
+			return p.Line < q.Line
+		}
+		return p.Col < q.Col
+	})
+}
+
+//AssembleFile assembles a source file from disk
+func AssembleFile(path string) ([]byte, vm.SourceMap, error) {
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return Assemble(path, src, os.ReadFile)
+}
+
+func (a *assembler) errorf(pos Pos, format string, args ...interface{}) {
+	a.errs = append(a.errs, &Error{pos, fmt.Sprintf(format, args...)})
+}
+
+//file is the first pass over a source: it defines the names and lays the statements out
+func (a *assembler) file(name string, src []byte, depth int) {
+
+	a.files = append(a.files, name)
+
+	for n, text := range strings.Split(string(src), "\n") {
+		at := func(col int) Pos { return Pos{name, n + 1, col} }
+
+		toks, err := lex(text)
+		if err != nil {
+			a.errorf(at(err.(*lexError).col), "%s", err)
+			continue
+		}
+
+		for len(toks) >= 2 && toks[1].text == ":" {
+			a.define(at(toks[0].col), toks[0].text, a.addr)
+			toks = toks[2:]
+		}
+		if len(toks) == 0 {
+			continue
+		}
+		pos := at(toks[0].col)
+
+		if len(toks) >= 2 && toks[1].text == "=" {
+			v, col, err := eval(toks[2:], a.symbols)
+			if err != nil {
+				a.errorf(at(col), "%s", err)
+				continue
+			}
+			a.define(pos, toks[0].text, v)
+			continue
+		}
+
+		op := strings.ToUpper(toks[0].text)
+		args := split(toks[1:])
+		if bad := emptyArg(args); bad >= 0 {
+			a.errorf(pos, "missing operand %d of %s", bad+1, toks[0].text)
+			continue
+		}
+		s := stmt{pos: pos, text: strings.TrimSpace(text), addr: a.addr, op: op, args: args}
+
+		switch op {
+		case "INCLUDE":
+			if len(toks) != 2 || toks[1].text[0] != '"' {
+				a.errorf(pos, "include needs a quoted file name")
+				continue
+			}
+			if depth == maxInclude {
+				a.errorf(pos, "includes nested too deeply")
+				continue
+			}
+			path := strings.Trim(toks[1].text, `"`)
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(filepath.Dir(name), path)
+			}
+			inc, err := a.open(path)
+			if err != nil {
+				a.errorf(at(toks[1].col), "%s", err)
+				continue
+			}
+			a.file(path, inc, depth+1)
+			continue
+		case "ORG":
+			v, col, err := eval(toks[1:], a.symbols)
+			if err != nil {
+				a.errorf(at(col), "%s", err)
+				continue
+			}
+			if v < a.addr || v > 0xFFFF {
+				a.errorf(at(toks[1].col), "org 0x%X is outside 0x%X-0xFFFF", v, a.addr)
+				continue
+			}
+			a.addr = v
+			continue
+		case "BYTE", "DB":
+			s.data = make([]byte, len(args))
+		case "WORD", "DW":
+			s.data = make([]byte, 2*len(args))
+		case "SPRITE":
+			if s.data, err = sprite(text, toks); err != nil {
+				a.errorf(at(err.(*lexError).col), "%s", err)
+				continue
+			}
+		default:
+			s.tmpl = match(op, args)
+			if s.tmpl == nil {
+				if _, ok := templates[op]; ok {
+					a.errorf(pos, "bad operands for %s", toks[0].text)
+				} else {
+					a.errorf(pos, "unknown instruction %q", toks[0].text)
+				}
+				continue
+			}
+			s.data = make([]byte, 2)
+			if s.tmpl.key == 0xF000 {
+				s.data = make([]byte, 4)
+			}
+		}
+
+		if s.addr+len(s.data) > 0x10000 {
+			a.errorf(pos, "program does not fit in memory")
+			continue
+		}
+		a.addr += len(s.data)
+		a.stmts = append(a.stmts, s)
+	}
+}
+
+//define gives a value to a name
+func (a *assembler) define(pos Pos, name string, v int) {
+
+	if !isWord(name[0]) || name[0] >= '0' && name[0] <= '9' {
+		a.errorf(pos, "bad name %q", name)
+		return
+	}
+	if _, ok := register(name); ok || keywords[strings.ToUpper(name)] || strings.EqualFold(name, "long") {
+		a.errorf(pos, "%q is reserved", name)
+		return
+	}
+	if _, ok := a.symbols[name]; ok {
+		a.errorf(pos, "%q redefined", name)
+		return
+	}
+	a.symbols[name] = v
+}
+
+//emit is the second pass over a statement, giving its bytes
+func (a *assembler) emit(s stmt) []byte {
+
+	value := func(arg []token, lo, hi int) int {
+		v, col, err := eval(arg, a.symbols)
+		if err != nil {
+			a.errorf(Pos{s.pos.File, s.pos.Line, col}, "%s", err)
+			return 0
+		}
+		if v < lo || v > hi {
+			a.errorf(Pos{s.pos.File, s.pos.Line, arg[0].col}, "%d is outside %d-%d", v, lo, hi)
+			return 0
+		}
+		return v
+	}
+
+	switch s.op {
+	case "BYTE", "DB":
+		for i, arg := range s.args {
+			s.data[i] = byte(value(arg, -0x80, 0xFF))
+		}
+	case "WORD", "DW":
+		for i, arg := range s.args {
+			v := value(arg, -0x8000, 0xFFFF)
+			s.data[2*i], s.data[2*i+1] = byte(v>>8), byte(v)
+		}
+	}
+	if s.tmpl == nil {
+		return s.data
+	}
+
+	op := int(s.tmpl.key)
+	for i, pattern := range s.tmpl.ops {
+		arg := s.args[i]
+		switch pattern {
+		case "Vx":
+			r, _ := register(arg[0].text)
+			op |= r << 8
+		case "Vy":
+			r, _ := register(arg[0].text)
+			op |= r << 4
+		case "addr":
+			op |= value(arg, 0, 0xFFF)
+		case "byte":
+			op |= value(arg, -0x80, 0xFF) & 0xFF
+		case "nibble":
+			op |= value(arg, 0, 0xF)
+		case "plane":
+			op |= value(arg, 0, 0xF) << 8
+		case "long":
+			v := value(arg[1:], 0, 0xFFFF)
+			s.data[2], s.data[3] = byte(v>>8), byte(v)
+		}
+	}
+	s.data[0], s.data[1] = byte(op>>8), byte(op)
+	return s.data
+}
+
+//match finds the template of an instruction by the shape of its operands
+func match(op string, args [][]token) *template {
+
+	ts := templates[op]
+	for i := range ts {
+		if ts[i].fits(args) {
+			return &ts[i]
+		}
+	}
+	return nil
+}
+
+func (t *template) fits(args [][]token) bool {
+
+	if len(args) != len(t.ops) {
+		return false
+	}
+	for i, pattern := range t.ops {
+		arg := args[i]
+		_, reg := register(arg[0].text)
+		reg = reg && len(arg) == 1
+		long := strings.EqualFold(arg[0].text, "long")
+
+		switch pattern {
+		case "Vx", "Vy":
+			if !reg {
+				return false
+			}
+		case "long":
+			if !long || len(arg) < 2 {
+				return false
+			}
+		case "addr", "byte", "nibble", "plane":
+			if reg || long || keywords[join(arg)] {
+				return false
+			}
+		default:
+			if join(arg) != pattern {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+//emptyArg gives the index of the first empty operand, or -1
+func emptyArg(args [][]token) int {
+
+	for i, arg := range args {
+		if len(arg) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+//sprite parses the rows of a sprite directive
+func sprite(text string, toks []token) ([]byte, error) {
+
+	if len(toks) < 2 {
+		return nil, &lexError{toks[0].col, "sprite needs rows"}
+	}
+	rest := text[toks[1].col-1:]
+	if i := strings.IndexByte(rest, ';'); i >= 0 {
+		rest = rest[:i]
+	}
+
+	var data []byte
+	col := toks[1].col
+	for _, row := range strings.Fields(rest) {
+		col += strings.Index(text[col-1:], row)
+		if len(row) != 8 && len(row) != 16 {
+			return nil, &lexError{col, fmt.Sprintf("sprite row %q is not 8 or 16 pixels", row)}
+		}
+		var bits int
+		for i := 0; i < len(row); i++ {
+			bits <<= 1
+			switch row[i] {
+			case '#', 'X', 'x', '1':
+				bits |= 1
+			case '.', '_', '0':
+			default:
+				return nil, &lexError{col + i, fmt.Sprintf("unexpected %q in sprite row", row[i])}
+			}
+		}
+		if len(row) == 16 {
+			data = append(data, byte(bits>>8))
+		}
+		data = append(data, byte(bits))
+		col += len(row)
+	}
+	return data, nil
+}
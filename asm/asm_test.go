@@ -0,0 +1,103 @@
+package asm
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/makoto126/term-atari/disasm"
+)
+
+func TestEval(t *testing.T) {
+
+	symbols := map[string]int{"X": 5, "base": 0x300}
+	for _, tc := range []struct {
+		expr string
+		want int
+	}{
+		{"0x1F", 0x1F},
+		{"0b101", 5},
+		{"-X", -5},
+		{"1 + 2 << 3", 17},
+		{"6 - 1 | 8 ^ 1", 12},
+		{"2 * 3 + 4", 10},
+		{"2 + 3 * 4", 14},
+		{"(2 + 3) * 4", 20},
+		{"0xFF & 0x0F | 0x30", 0x3F},
+		{"base + X * 2", 0x30A},
+		{"100 / 7 / 2", 7},
+		{"10 - 3 - 2", 5},
+		{"1 << 4 >> 2", 4},
+	} {
+		toks, err := lex(tc.expr)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.expr, err)
+		}
+		if got, _, err := eval(toks, symbols); err != nil || got != tc.want {
+			t.Errorf("%s = %d, %v, want %d", tc.expr, got, err, tc.want)
+		}
+	}
+
+	for _, s := range []string{"1 +", "(1", "1 / 0", "Y", "1 2"} {
+		toks, err := lex(s)
+		if err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+		if _, _, err := eval(toks, symbols); err == nil {
+			t.Errorf("%s evaluated without an error", s)
+		}
+	}
+}
+
+func TestAssemble(t *testing.T) {
+
+	for _, tc := range []struct {
+		src  string
+		want []byte
+	}{
+		{"CLS\nRET", []byte{0x00, 0xE0, 0x00, 0xEE}},
+		{"X = 1 + 2 << 3\nLD V0, X", []byte{0x60, 0x11}},
+		{"loop: ADD V1, 1\nJP loop", []byte{0x71, 0x01, 0x12, 0x00}},
+		{"JP end\nend: byte 1, 2", []byte{0x12, 0x02, 0x01, 0x02}},
+		{"word 0x1234, here\nhere:", []byte{0x12, 0x34, 0x02, 0x04}},
+		{"sprite ..####..\nsprite #.#.#.#.", []byte{0x3C, 0xAA}},
+		{"LD I, long 0x1234", []byte{0xF0, 0x00, 0x12, 0x34}},
+		{"org 0x204\nCLS", []byte{0, 0, 0, 0, 0x00, 0xE0}},
+	} {
+		rom, _, err := Assemble("test.asm", []byte(tc.src), nil)
+		if err != nil {
+			t.Errorf("%q: %v", tc.src, err)
+		} else if !bytes.Equal(rom, tc.want) {
+			t.Errorf("%q assembled to % X, want % X", tc.src, rom, tc.want)
+		}
+	}
+}
+
+//TestRoundTrip assembles the listings of the bundled ROMs back into the same ROMs
+func TestRoundTrip(t *testing.T) {
+
+	roms, err := filepath.Glob("../roms/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roms) == 0 {
+		t.Fatal("no ROMs in ../roms")
+	}
+	for _, name := range roms {
+		rom, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var listing bytes.Buffer
+		if err := disasm.Disassemble(&listing, name, rom); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		got, _, err := Assemble(name+".asm", listing.Bytes(), nil)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		} else if !bytes.Equal(got, rom) {
+			t.Errorf("%s does not assemble back into the same ROM", name)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package asm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//token is a word or punctuation of a source line, col counting from 1
+type token struct {
+	text string
+	col  int
+}
+
+//lex splits a line into tokens, dropping the comment
+func lex(s string) ([]token, error) {
+
+	var toks []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ';':
+			return toks, nil
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case isWord(c):
+			j := i
+			for j < len(s) && isWord(s[j]) {
+				j++
+			}
+			toks = append(toks, token{s[i:j], i + 1})
+			i = j
+		case c == '"':
+			j := strings.IndexByte(s[i+1:], '"')
+			if j < 0 {
+				return nil, &lexError{i + 1, "unterminated string"}
+			}
+			toks = append(toks, token{s[i : i+j+2], i + 1})
+			i += j + 2
+		case i+1 < len(s) && (s[i:i+2] == "<<" || s[i:i+2] == ">>"):
+			toks = append(toks, token{s[i : i+2], i + 1})
+			i += 2
+		case strings.IndexByte(",:=+-*/&|^()[]#.", c) >= 0:
+			toks = append(toks, token{s[i : i+1], i + 1})
+			i++
+		default:
+			return nil, &lexError{i + 1, fmt.Sprintf("unexpected %q", c)}
+		}
+	}
+	return toks, nil
+}
+
+type lexError struct {
+	col int
+	msg string
+}
+
+func (e *lexError) Error() string {
+	return e.msg
+}
+
+func isWord(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+//join rebuilds the text of tokens, upper cased, for matching fixed operands such as [I]
+func join(toks []token) string {
+
+	var sb strings.Builder
+	for _, t := range toks {
+		sb.WriteString(strings.ToUpper(t.text))
+	}
+	return sb.String()
+}
+
+//split cuts tokens at the top level commas
+func split(toks []token) [][]token {
+
+	if len(toks) == 0 {
+		return nil
+	}
+	var out [][]token
+	depth, start := 0, 0
+	for i, t := range toks {
+		switch t.text {
+		case "(", "[":
+			depth++
+		case ")", "]":
+			depth--
+		case ",":
+			if depth == 0 {
+				out = append(out, toks[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(out, toks[start:])
+}
+
+//register parses V0-VF
+func register(s string) (int, bool) {
+
+	if len(s) != 2 || (s[0] != 'V' && s[0] != 'v') {
+		return 0, false
+	}
+	r, err := strconv.ParseUint(s[1:], 16, 8)
+	return int(r), err == nil
+}
+
+//exprOps lists the binary operators from the loosest to the tightest binding, in the levels of Go
+var exprOps = [][]string{
+	{"+", "-", "|", "^"},
+	{"*", "/", "<<", ">>", "&"},
+}
+
+//expr evaluates constant expressions, looking names up in symbols
+type expr struct {
+	toks    []token
+	pos     int
+	symbols map[string]int
+}
+
+//eval evaluates an expression, reporting the column of any error
+func eval(toks []token, symbols map[string]int) (int, int, error) {
+
+	if len(toks) == 0 {
+		return 0, 0, fmt.Errorf("missing operand")
+	}
+	e := &expr{toks: toks, symbols: symbols}
+	v, err := e.binary(0)
+	if err == nil && e.pos < len(toks) {
+		err = fmt.Errorf("unexpected %q", toks[e.pos].text)
+	}
+	if err != nil {
+		return 0, e.col(), err
+	}
+	return v, 0, nil
+}
+
+func (e *expr) col() int {
+	if e.pos < len(e.toks) {
+		return e.toks[e.pos].col
+	}
+	last := e.toks[len(e.toks)-1]
+	return last.col + len(last.text)
+}
+
+func (e *expr) peek() string {
+	if e.pos < len(e.toks) {
+		return e.toks[e.pos].text
+	}
+	return ""
+}
+
+func (e *expr) binary(level int) (int, error) {
+
+	if level == len(exprOps) {
+		return e.unary()
+	}
+
+	l, err := e.binary(level + 1)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op := e.peek()
+		found := false
+		for _, o := range exprOps[level] {
+			found = found || o == op
+		}
+		if !found {
+			return l, nil
+		}
+		e.pos++
+		at := e.pos
+		r, err := e.binary(level + 1)
+		if err != nil {
+			return 0, err
+		}
+		switch op {
+		case "|":
+			l |= r
+		case "^":
+			l ^= r
+		case "&":
+			l &= r
+		case "<<":
+			l <<= uint(r & 31)
+		case ">>":
+			l >>= uint(r & 31)
+		case "+":
+			l += r
+		case "-":
+			l -= r
+		case "*":
+			l *= r
+		case "/":
+			if r == 0 {
+				e.pos = at
+				return 0, fmt.Errorf("division by zero")
+			}
+			l /= r
+		}
+	}
+}
+
+func (e *expr) unary() (int, error) {
+
+	switch e.peek() {
+	case "-":
+		e.pos++
+		v, err := e.unary()
+		return -v, err
+	case "(":
+		e.pos++
+		v, err := e.binary(0)
+		if err != nil {
+			return 0, err
+		}
+		if e.peek() != ")" {
+			return 0, fmt.Errorf("missing \")\"")
+		}
+		e.pos++
+		return v, nil
+	case "":
+		return 0, fmt.Errorf("missing operand")
+	}
+
+	tok := e.toks[e.pos].text
+	if tok[0] >= '0' && tok[0] <= '9' {
+		n, err := strconv.ParseInt(tok, 0, 32)
+		if err != nil {
+			return 0, fmt.Errorf("bad number %q", tok)
+		}
+		e.pos++
+		return int(n), nil
+	}
+	if !isWord(tok[0]) {
+		return 0, fmt.Errorf("unexpected %q", tok)
+	}
+	v, ok := e.symbols[tok]
+	if !ok {
+		return 0, fmt.Errorf("undefined %q", tok)
+	}
+	e.pos++
+	return v, nil
+}
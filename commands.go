@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/makoto126/term-atari/disasm"
+	"github.com/makoto126/term-atari/headless"
+)
+
+//disasmCmd prints the listing of each ROM given: term-atari disasm ROM...
+func disasmCmd(args []string) error {
+
+	if len(args) == 0 {
+		return errors.New("usage: term-atari disasm ROM...")
+	}
+
+	for _, path := range args {
+		data, err := readRom(path)
+		if err != nil {
+			return err
+		}
+		if err := disasm.Disassemble(os.Stdout, path, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//runCmd plays a ROM, assembly or Octo source without the menu: term-atari run FILE
+func runCmd(args []string) error {
+
+	if len(args) != 1 {
+		return errors.New("usage: term-atari run FILE")
+	}
+
+	p, err := readProgram(args[0])
+	if err != nil {
+		return err
+	}
+	return play(p)
+}
+
+//headlessCmd runs a program without a terminal and dumps its last frame as text:
+//term-atari headless [--cycles N | --frames N] [--keys SCRIPT] [--dump-frame FILE] FILE
+func headlessCmd(args []string) error {
+
+	fs := flag.NewFlagSet("headless", flag.ContinueOnError)
+	cycles := fs.Int("cycles", 0, "number of instructions to run")
+	frames := fs.Int("frames", 0, "number of 60Hz frames to run, instead of -cycles")
+	keys := fs.String("keys", "", "comma separated key presses frame:key[:frames], such as \"60:5:10, 90:A\"")
+	dump := fs.String("dump-frame", "-", "file to write the last frame to as text, - for stdout")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: term-atari headless [options] FILE\n\noptions:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err == flag.ErrHelp {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || (*cycles > 0) == (*frames > 0) {
+		fs.Usage()
+		return errors.New("headless needs a FILE and one of -cycles or -frames")
+	}
+
+	script, err := headless.ParseScript(*keys)
+	if err != nil {
+		return err
+	}
+	p, err := readProgram(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	entry, q := lookup(p.rom)
+	m, err := headless.New(p.rom, q, tickRate(entry.TickRate, 0), script)
+	if err != nil {
+		return err
+	}
+	if *frames > 0 {
+		err = m.RunFrames(*frames)
+	} else {
+		err = m.RunCycles(*cycles)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: frame %d: %v", p.name, m.Frame(), err)
+	}
+
+	if *dump == "-" || *dump == "" {
+		return headless.Dump(os.Stdout, m.Display())
+	}
+	f, err := os.Create(*dump)
+	if err != nil {
+		return err
+	}
+	if err := headless.Dump(f, m.Display()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
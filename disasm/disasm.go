@@ -0,0 +1,239 @@
+//Package disasm turns CHIP-8 ROMs into annotated Cowgod style assembly.
+//It decodes instructions with the same table vm.Chip8 executes them with,
+//and follows the control flow from the entry point to tell code from sprite data.
+package disasm
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/makoto126/term-atari/vm"
+)
+
+//Base is the address ROMs are loaded at
+const Base = 0x200
+
+//label kinds, in increasing order of precedence
+const (
+	dataLabel = iota + 1
+	jumpLabel
+	callLabel
+)
+
+//Format renders the instruction opcode, with next the word after it for F000 NNNN.
+//It reports false for opcodes the vm does not know.
+func Format(opcode, next uint16) (string, bool) {
+	return format(opcode, next, nil)
+}
+
+//Size is the length in bytes of the instruction opcode
+func Size(opcode uint16) int {
+	if opcode == 0xF000 {
+		return 4
+	}
+	return 2
+}
+
+func format(opcode, next uint16, name func(uint16) string) (string, bool) {
+
+	asm, ok := vm.Mnemonic(opcode)
+	if !ok {
+		return "", false
+	}
+
+	addr := func(a uint16, digits int) string {
+		if name != nil {
+			if l := name(a); l != "" {
+				return l
+			}
+		}
+		return fmt.Sprintf("0x%0*X", digits, a)
+	}
+
+	fields := strings.Fields(strings.Replace(asm, ",", " ,", -1))
+	for i, f := range fields {
+		switch f {
+		case "Vx":
+			fields[i] = fmt.Sprintf("V%X", (opcode&0x0F00)>>8)
+		case "Vy":
+			fields[i] = fmt.Sprintf("V%X", (opcode&0x00F0)>>4)
+		case "addr":
+			fields[i] = addr(opcode&0x0FFF, 3)
+		case "long":
+			fields[i] = "long " + addr(next, 4)
+		case "byte":
+			fields[i] = fmt.Sprintf("0x%02X", opcode&0x00FF)
+		case "nibble":
+			fields[i] = fmt.Sprint(opcode & 0x000F)
+		case "plane":
+			fields[i] = fmt.Sprint((opcode & 0x0F00) >> 8)
+		}
+	}
+	return strings.Replace(strings.Join(fields, " "), " ,", ",", -1), true
+}
+
+//program is a ROM with the results of the control flow analysis
+type program struct {
+	rom    []byte
+	code   []bool
+	starts map[uint16]bool
+	labels map[uint16]int
+	//dynamic lists the JP V0 instructions whose targets are unknown
+	dynamic map[uint16]bool
+}
+
+//Disassemble writes the listing of a ROM to w
+func Disassemble(w io.Writer, name string, rom []byte) error {
+
+	p := &program{
+		rom:     rom,
+		code:    make([]bool, len(rom)),
+		starts:  make(map[uint16]bool),
+		labels:  make(map[uint16]int),
+		dynamic: make(map[uint16]bool),
+	}
+	p.trace(Base)
+
+	if _, err := fmt.Fprintf(w, "; %s, %d bytes\n", name, len(rom)); err != nil {
+		return err
+	}
+
+	for a := 0; a < len(rom); {
+		addr := uint16(Base + a)
+		if l := p.label(addr); l != "" {
+			if _, err := fmt.Fprintf(w, "\n%s:\n", l); err != nil {
+				return err
+			}
+		}
+
+		var line string
+		if p.starts[addr] {
+			op, next := p.word(addr), p.word(addr+2)
+			text, _ := format(op, next, p.label)
+			n := Size(op)
+			raw := fmt.Sprintf("%04X", op)
+			if n == 4 {
+				raw += fmt.Sprintf(" %04X", next)
+			}
+			comment := ""
+			if p.dynamic[addr] {
+				comment = "  jump table"
+			}
+			line = fmt.Sprintf("\t%-24s; %04X  %s%s", text, addr, raw, comment)
+			a += n
+		} else {
+			b := rom[a]
+			line = fmt.Sprintf("\t%-24s; %04X  %02X    %s", fmt.Sprintf("db 0x%02X", b), addr, b, bits(b))
+			a++
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//trace marks every instruction reachable from addr as code
+func (p *program) trace(entry uint16) {
+
+	work := []uint16{entry}
+	for len(work) > 0 {
+		a := work[len(work)-1]
+		work = work[:len(work)-1]
+
+		if !p.inside(a, 2) || p.starts[a] || p.code[a-Base] {
+			continue
+		}
+		op := p.word(a)
+		key := vm.Decode(op)
+		if _, ok := vm.Mnemonic(op); !ok || !p.inside(a, Size(op)) {
+			continue
+		}
+		p.starts[a] = true
+		for i := 0; i < Size(op); i++ {
+			p.code[int(a)-Base+i] = true
+		}
+
+		next := a + uint16(Size(op))
+		nnn := op & 0x0FFF
+		switch key {
+		case 0x1000:
+			p.mark(nnn, jumpLabel)
+			work = append(work, nnn)
+		case 0x2000:
+			p.mark(nnn, callLabel)
+			work = append(work, nnn, next)
+		case 0xB000:
+			p.mark(nnn, jumpLabel)
+			p.dynamic[a] = true
+		case 0x00EE, 0x00FD:
+		case 0x3000, 0x4000, 0x5000, 0x9000, 0xE09E, 0xE0A1:
+			skip := next + 2
+			if p.inside(next, 2) && p.word(next) == 0xF000 {
+				skip += 2
+			}
+			work = append(work, next, skip)
+		case 0xA000:
+			p.mark(nnn, dataLabel)
+			work = append(work, next)
+		case 0xF000:
+			p.mark(p.word(a+2), dataLabel)
+			work = append(work, next)
+		default:
+			work = append(work, next)
+		}
+	}
+}
+
+func (p *program) mark(addr uint16, kind int) {
+	if kind > p.labels[addr] {
+		p.labels[addr] = kind
+	}
+}
+
+//label names an address, if it is labelled and starts a line of the listing
+func (p *program) label(addr uint16) string {
+
+	if !p.inside(addr, 1) {
+		return ""
+	}
+	if p.code[addr-Base] && !p.starts[addr] {
+		return ""
+	}
+	switch p.labels[addr] {
+	case callLabel:
+		return fmt.Sprintf("sub_%03X", addr)
+	case jumpLabel:
+		return fmt.Sprintf("lbl_%03X", addr)
+	case dataLabel:
+		return fmt.Sprintf("dat_%03X", addr)
+	}
+	return ""
+}
+
+func (p *program) inside(addr uint16, n int) bool {
+	return int(addr) >= Base && int(addr)+n <= Base+len(p.rom)
+}
+
+func (p *program) word(addr uint16) uint16 {
+	if !p.inside(addr, 2) {
+		return 0
+	}
+	i := addr - Base
+	return uint16(p.rom[i])<<8 | uint16(p.rom[i+1])
+}
+
+//bits draws a byte of sprite data
+func bits(b byte) string {
+
+	var sb strings.Builder
+	for i := uint(0); i < 8; i++ {
+		if b&(0x80>>i) != 0 {
+			sb.WriteByte('#')
+		} else {
+			sb.WriteByte('.')
+		}
+	}
+	return sb.String()
+}
@@ -1,10 +1,9 @@
 module github.com/makoto126/term-atari
 
-go 1.13
+go 1.16
 
 require (
 	github.com/gdamore/tcell/v2 v2.0.0
-	github.com/shuLhan/go-bindata v4.0.0+incompatible // indirect
 	golang.org/x/tools v0.0.0-20201118215654-4d9c4f8a78b0 // indirect
 	honnef.co/go/tools v0.0.1-2020.1.6 // indirect
 )
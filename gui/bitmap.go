@@ -0,0 +1,231 @@
+package gui
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/makoto126/term-atari/vm"
+)
+
+const (
+	//cellW and cellH are the pixel size of a terminal cell that bitmap renderers assume
+	//to tell how many cells their image covers
+	cellW = 8
+	cellH = 16
+	//kittyChunk is the most base64 a kitty graphics escape carries
+	kittyChunk = 4096
+	//presentInterval is how often bitmap renderers redraw a changed display
+	presentInterval = time.Second / 60
+)
+
+type (
+	//bitmap draws the display as an image, every pixel taking scale x scale screen pixels.
+	//It leaves the cells alone, writing the image over them to stdout.
+	bitmap struct {
+		encode func(io.Writer, *image.Paletted, int) error
+		scale  int
+	}
+
+	//presenter is a Renderer that draws the whole display at once rather than cell by cell
+	presenter interface {
+		present(w io.Writer, d *vm.Display) error
+	}
+)
+
+func (b bitmap) size(w, h int) (int, int) {
+	return (w*b.scale + cellW - 1) / cellW, (h*b.scale + cellH - 1) / cellH
+}
+
+func (bitmap) fill(s tcell.Screen, d *vm.Display, i, j int) {}
+
+//present writes the image of the display at the top left corner of the terminal,
+//leaving the cursor where it was
+func (b bitmap) present(w io.Writer, d *vm.Display) error {
+
+	var buf bytes.Buffer
+	buf.WriteString("\x1b7\x1b[H")
+	if err := b.encode(&buf, displayImage(d), b.scale); err != nil {
+		return err
+	}
+	buf.WriteString("\x1b8")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+//presentLoop draws the displays the Term is presented with a presenter, at most once a frame,
+//until the Term quits. Encoding runs on its own goroutine so it never holds up the machine;
+//the image is written to the terminal under the screen lock, between the updates of the screen.
+func (t *Term) presentLoop(p presenter) {
+
+	tick := time.NewTicker(presentInterval)
+	defer tick.Stop()
+	var buf bytes.Buffer
+	for {
+		select {
+		case <-t.quit:
+			return
+		case <-tick.C:
+		}
+		select {
+		case d := <-t.frames:
+			buf.Reset()
+			if p.present(&buf, &d) != nil {
+				break
+			}
+			t.mu.Lock()
+			select {
+			case <-t.quit:
+			default:
+				os.Stdout.Write(buf.Bytes())
+			}
+			t.mu.Unlock()
+		default:
+		}
+	}
+}
+
+//displayImage is the display as an image, colored with the palette
+func displayImage(d *vm.Display) *image.Paletted {
+
+	pal := make(color.Palette, len(palette))
+	for i, c := range palette {
+		r, g, b := c.RGB()
+		pal[i] = color.RGBA{uint8(r), uint8(g), uint8(b), 0xFF}
+	}
+
+	w, h := d.Size()
+	img := image.NewPaletted(image.Rect(0, 0, w, h), pal)
+	for i := 0; i < w; i++ {
+		for j := 0; j < h; j++ {
+			img.SetColorIndex(i, j, uint8(d.Pixel(i, j)))
+		}
+	}
+	return img
+}
+
+//EncodeSixel writes img as a sixel escape sequence, each pixel scaled to scale x scale.
+//Every color of the palette is painted, so the image covers whatever was drawn before it.
+func EncodeSixel(w io.Writer, img *image.Paletted, scale int) error {
+
+	b := img.Bounds()
+	width, height := b.Dx()*scale, b.Dy()*scale
+	at := func(x, y int) uint8 {
+		return img.ColorIndexAt(b.Min.X+x/scale, b.Min.Y+y/scale)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "\x1bPq\"1;1;%d;%d", width, height)
+	for i, c := range img.Palette {
+		r, g, bl, _ := c.RGBA()
+		fmt.Fprintf(&buf, "#%d;2;%d;%d;%d", i, percent(r), percent(g), percent(bl))
+	}
+
+	row := make([]byte, width)
+	for band := 0; band < height; band += 6 {
+		if band > 0 {
+			buf.WriteByte('-')
+		}
+		first := true
+		for i := range img.Palette {
+			used := false
+			for x := 0; x < width; x++ {
+				var bits byte
+				for dy := 0; dy < 6 && band+dy < height; dy++ {
+					if at(x, band+dy) == uint8(i) {
+						bits |= 1 << uint(dy)
+					}
+				}
+				row[x] = '?' + bits
+				used = used || bits != 0
+			}
+			if !used {
+				continue
+			}
+			if !first {
+				buf.WriteByte('$')
+			}
+			first = false
+			fmt.Fprintf(&buf, "#%d", i)
+			sixelRuns(&buf, row)
+		}
+	}
+	buf.WriteString("\x1b\\")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+//sixelRuns writes a row of sixels, run length encoding repeats
+func sixelRuns(buf *bytes.Buffer, row []byte) {
+
+	for x := 0; x < len(row); {
+		n := 1
+		for x+n < len(row) && row[x+n] == row[x] {
+			n++
+		}
+		if n > 3 {
+			fmt.Fprintf(buf, "!%d%c", n, row[x])
+		} else {
+			buf.Write(row[x : x+n])
+		}
+		x += n
+	}
+}
+
+//percent scales a 16 bit color component to the 0-100 of sixel colors
+func percent(c uint32) uint32 {
+	return (c*100 + 0x7FFF) / 0xFFFF
+}
+
+//EncodeKitty writes img as kitty graphics protocol escape sequences, each pixel scaled to scale x scale.
+//The image is sent as zlib compressed RGB, replacing the image and placement with id 1 without moving the cursor.
+func EncodeKitty(w io.Writer, img *image.Paletted, scale int) error {
+
+	b := img.Bounds()
+	width, height := b.Dx()*scale, b.Dy()*scale
+
+	var z bytes.Buffer
+	zw := zlib.NewWriter(&z)
+	rgb := make([]byte, 0, 3*width)
+	for y := 0; y < height; y++ {
+		rgb = rgb[:0]
+		for x := 0; x < width; x++ {
+			r, g, bl, _ := img.At(b.Min.X+x/scale, b.Min.Y+y/scale).RGBA()
+			rgb = append(rgb, byte(r>>8), byte(g>>8), byte(bl>>8))
+		}
+		zw.Write(rgb)
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	data := base64.StdEncoding.EncodeToString(z.Bytes())
+	var buf bytes.Buffer
+	for first := true; first || data != ""; first = false {
+		chunk := data
+		if len(chunk) > kittyChunk {
+			chunk = chunk[:kittyChunk]
+		}
+		data = data[len(chunk):]
+		more := 0
+		if data != "" {
+			more = 1
+		}
+		if first {
+			fmt.Fprintf(&buf, "\x1b_Ga=T,f=24,o=z,s=%d,v=%d,i=1,p=1,q=2,C=1,m=%d;%s\x1b\\", width, height, more, chunk)
+		} else {
+			fmt.Fprintf(&buf, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
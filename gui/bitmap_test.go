@@ -0,0 +1,76 @@
+package gui
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/color"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+//testImage is a 16x8 image using every color of the palette: a checkerboard of the
+//first plane, the second plane on the left and both planes on a diagonal
+func testImage() *image.Paletted {
+
+	pal := color.Palette{
+		color.RGBA{0x00, 0x00, 0x00, 0xFF},
+		color.RGBA{0xFF, 0xFF, 0xFF, 0xFF},
+		color.RGBA{0xFF, 0xA5, 0x00, 0xFF},
+		color.RGBA{0x80, 0x00, 0x00, 0xFF},
+	}
+	img := image.NewPaletted(image.Rect(0, 0, 16, 8), pal)
+	for x := 0; x < 16; x++ {
+		for y := 0; y < 8; y++ {
+			var c uint8
+			if (x+y)%2 == 0 {
+				c = 1
+			}
+			if x < 4 {
+				c = 2
+			}
+			if x == 2*y {
+				c = 3
+			}
+			img.SetColorIndex(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestEncode(t *testing.T) {
+
+	for _, tc := range []struct {
+		golden string
+		encode func(io.Writer, *image.Paletted, int) error
+		scale  int
+	}{
+		{"sixel.golden", EncodeSixel, 1},
+		{"sixel_scaled.golden", EncodeSixel, 3},
+		{"kitty.golden", EncodeKitty, 1},
+		{"kitty_scaled.golden", EncodeKitty, 3},
+	} {
+		var buf bytes.Buffer
+		if err := tc.encode(&buf, testImage(), tc.scale); err != nil {
+			t.Fatalf("%s: %v", tc.golden, err)
+		}
+
+		golden := filepath.Join("testdata", tc.golden)
+		if *update {
+			if err := os.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("%s:\n got %q\nwant %q", tc.golden, buf.Bytes(), want)
+		}
+	}
+}
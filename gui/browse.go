@@ -0,0 +1,191 @@
+package gui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/makoto126/term-atari/library"
+)
+
+//nameWidth is the width of the file name column of the browser
+const nameWidth = 48
+
+var (
+	dirStyle  = tcell.StyleDefault.Foreground(tcell.ColorBlue)
+	helpStyle = tcell.StyleDefault.Foreground(tcell.ColorGreen)
+)
+
+//fileEntry is a line of the file browser
+type fileEntry struct {
+	name string
+	dir  bool
+	size int64
+}
+
+//browse lets the player pick a file, starting in dir.
+//It returns the path of the file, or "" when the player goes back to the menu.
+func browse(s tcell.Screen, dir string) string {
+
+	dir, _ = filepath.Abs(dir)
+	all := false
+	selected, top := 0, 0
+	files, err := listDir(dir, all)
+
+	//cd moves to another directory, selecting the entry named from if there is one
+	cd := func(to, from string) {
+		dir, selected, top = to, 0, 0
+		files, err = listDir(dir, all)
+		for i, f := range files {
+			if f.name == from {
+				selected = i
+			}
+		}
+	}
+
+	for {
+		_, h := s.Size()
+		rows := h - 4
+		if rows < 1 {
+			rows = 1
+		}
+		if selected < top {
+			top = selected
+		}
+		if selected >= top+rows {
+			top = selected - rows + 1
+		}
+
+		s.Clear()
+		puts(s, 0, 0, dir, helpStyle)
+		if err != nil {
+			puts(s, 0, 1, err.Error(), tcell.StyleDefault.Foreground(tcell.ColorRed))
+		}
+		for i := top; i < len(files) && i < top+rows; i++ {
+			f := files[i]
+			style := tcell.StyleDefault
+			line := fmt.Sprintf("%-*s %10s", nameWidth, f.name, formatSize(f.size))
+			if f.dir {
+				style = dirStyle
+				line = fmt.Sprintf("%-*s", nameWidth+11, f.name+"/")
+			}
+			puts(s, 0, 2+i-top, line, style.Reverse(i == selected))
+		}
+		filter := "ROMs only"
+		if all {
+			filter = "all files"
+		}
+		puts(s, 0, h-1, "↑, ↓, ENTER open, ←, BACKSPACE parent, TAB "+filter+", ESC menu", helpStyle)
+		s.Show()
+
+		switch ev := s.PollEvent().(type) {
+		case *tcell.EventKey:
+			switch ev.Key() {
+			case tcell.KeyEscape:
+				return ""
+			case tcell.KeyUp:
+				if selected > 0 {
+					selected--
+				}
+			case tcell.KeyDown:
+				if selected < len(files)-1 {
+					selected++
+				}
+			case tcell.KeyPgUp:
+				if selected -= rows; selected < 0 {
+					selected = 0
+				}
+			case tcell.KeyPgDn:
+				if selected += rows; selected > len(files)-1 {
+					selected = len(files) - 1
+				}
+			case tcell.KeyEnter, tcell.KeyRight:
+				if selected >= len(files) {
+					break
+				}
+				f := files[selected]
+				switch {
+				case f.name == "..":
+					cd(filepath.Dir(dir), filepath.Base(dir))
+				case f.dir:
+					cd(filepath.Join(dir, f.name), "")
+				default:
+					return filepath.Join(dir, f.name)
+				}
+			case tcell.KeyLeft, tcell.KeyBackspace, tcell.KeyBackspace2:
+				cd(filepath.Dir(dir), filepath.Base(dir))
+			case tcell.KeyTab:
+				all = !all
+				name := ""
+				if selected < len(files) {
+					name = files[selected].name
+				}
+				cd(dir, name)
+			}
+		case *tcell.EventResize:
+			s.Sync()
+		}
+	}
+}
+
+//listDir lists the directories and, unless all is set, the ROM files and archives of dir,
+//skipping hidden ones
+func listDir(dir string, all bool) ([]fileEntry, error) {
+
+	var files []fileEntry
+	if parent := filepath.Dir(dir); parent != dir {
+		files = append(files, fileEntry{name: "..", dir: true})
+	}
+
+	infos, err := os.ReadDir(dir)
+	if err != nil {
+		return files, err
+	}
+
+	var dirs, roms []fileEntry
+	for _, de := range infos {
+		if strings.HasPrefix(de.Name(), ".") {
+			continue
+		}
+		fi, err := os.Stat(filepath.Join(dir, de.Name()))
+		if err != nil {
+			continue
+		}
+		switch {
+		case fi.IsDir():
+			dirs = append(dirs, fileEntry{name: de.Name(), dir: true})
+		case all || library.IsRom(de.Name()) || library.IsArchive(de.Name()):
+			roms = append(roms, fileEntry{name: de.Name(), size: fi.Size()})
+		}
+	}
+
+	for _, list := range [][]fileEntry{dirs, roms} {
+		sort.Slice(list, func(i, j int) bool {
+			return strings.ToLower(list[i].name) < strings.ToLower(list[j].name)
+		})
+		files = append(files, list...)
+	}
+	return files, nil
+}
+
+func formatSize(n int64) string {
+
+	switch {
+	case n < 1<<10:
+		return fmt.Sprintf("%d B", n)
+	case n < 1<<20:
+		return fmt.Sprintf("%.1f KiB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%.1f MiB", float64(n)/(1<<20))
+}
+
+//puts writes str from column x of row y
+func puts(s tcell.Screen, x, y int, str string, style tcell.Style) {
+	for _, r := range str {
+		s.SetContent(x, y, r, nil, style)
+		x++
+	}
+}
@@ -0,0 +1,206 @@
+package gui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/makoto126/term-atari/disasm"
+	"github.com/makoto126/term-atari/vm"
+)
+
+var (
+	debugStyle = tcell.StyleDefault.Foreground(tcell.ColorGreen)
+	pcStyle    = tcell.StyleDefault.Foreground(tcell.ColorYellow)
+)
+
+//Debug attaches the step debugger of c to the Term.
+//The panel starts visible if show is set; F12 toggles it at any time.
+func (t *Term) Debug(c *vm.Chip8, show bool) {
+
+	t.chip8 = c
+	t.panel = show
+	c.OnDebug(func(d vm.Debug) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.view = d
+		if t.panel {
+			t.drawPanel()
+		}
+		t.drawStatus()
+	})
+}
+
+//debugKey handles the debugger hotkeys, reporting whether ev was one
+func (t *Term) debugKey(ev *tcell.EventKey) bool {
+
+	if t.chip8 == nil {
+		return false
+	}
+
+	if t.prompting {
+		t.promptKey(ev)
+		return true
+	}
+
+	switch ev.Key() {
+	case tcell.KeyRune:
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if ev.Rune() != ':' || !t.panel {
+			return false
+		}
+		t.prompting, t.prompt, t.cmdErr = true, "", ""
+		t.drawPanel()
+	case tcell.KeyF9:
+		t.togglePause()
+	case tcell.KeyF10:
+		t.chip8.Step()
+	case tcell.KeyF11:
+		t.chip8.ToggleBreakpoint()
+	case tcell.KeyF12:
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.panel = !t.panel
+		if t.panel {
+			t.drawPanel()
+		} else {
+			t.clearPanel()
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+//promptKey edits the debugger command line
+func (t *Term) promptKey(ev *tcell.EventKey) {
+
+	//the command runs unlocked, as the machine reports to the panel while taking it
+	var err error
+	if ev.Key() == tcell.KeyEnter {
+		err = t.chip8.Command(t.prompt)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		t.prompting = false
+	case tcell.KeyEnter:
+		t.prompting = false
+		if err != nil {
+			t.cmdErr = err.Error()
+		}
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if len(t.prompt) > 0 {
+			t.prompt = t.prompt[:len(t.prompt)-1]
+		}
+	case tcell.KeyRune:
+		t.prompt += string(ev.Rune())
+	}
+	t.drawPanel()
+}
+
+//debugView is the last view of the machine it reported
+func (t *Term) debugView() vm.Debug {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.view
+}
+
+func (t *Term) drawPanel() {
+
+	d := t.view
+	lines := []string{
+		fmt.Sprintf("PC %04X  I %04X  SP %X", d.PC, d.I, d.SP),
+		fmt.Sprintf("DT %02X    ST %02X", d.DT, d.ST),
+		"",
+	}
+	for r := 0; r < 16; r += 4 {
+		lines = append(lines, fmt.Sprintf("V%X %02X  V%X %02X  V%X %02X  V%X %02X",
+			r, d.V[r], r+1, d.V[r+1], r+2, d.V[r+2], r+3, d.V[r+3]))
+	}
+
+	lines = append(lines, "", "Stack")
+	for i := 0; i < int(d.SP) && i < len(d.Stack); i++ {
+		lines = append(lines, fmt.Sprintf(" %X %04X", i, d.Stack[i]))
+	}
+
+	lines = append(lines, "", "Code")
+	for i := 0; i+1 < len(d.Code); {
+		op := uint16(d.Code[i])<<8 | uint16(d.Code[i+1])
+		var next uint16
+		if i+3 < len(d.Code) {
+			next = uint16(d.Code[i+2])<<8 | uint16(d.Code[i+3])
+		}
+		text, ok := disasm.Format(op, next)
+		if !ok {
+			text = "???"
+		}
+		lines = append(lines, fmt.Sprintf(" %04X %04X  %s", int(d.PC)+i, op, text))
+		i += disasm.Size(op)
+	}
+	if src := d.Source; src.File != "" {
+		lines = append(lines, "", fmt.Sprintf("%s:%d", filepath.Base(src.File), src.Line), " "+src.Text)
+	}
+
+	bps := make([]string, len(d.Breakpoints))
+	for i, a := range d.Breakpoints {
+		bps[i] = fmt.Sprintf("%04X", a)
+	}
+	lines = append(lines, "", "Breakpoints "+strings.Join(bps, " "))
+	for _, w := range d.Watches {
+		lines = append(lines, " "+w)
+	}
+	lines = append(lines, "")
+
+	if d.Paused {
+		lines = append(lines, "[paused] "+d.Reason)
+	} else {
+		lines = append(lines, "[running]")
+	}
+	lines = append(lines,
+		"F9 pause/resume  F10 step",
+		"F11 breakpoint at PC  F12 hide",
+		": command (b, r, w, a, d)",
+	)
+	if t.prompting {
+		lines = append(lines, ":"+t.prompt)
+	} else if t.cmdErr != "" {
+		lines = append(lines, t.cmdErr)
+	}
+
+	t.clearPanel()
+	px := t.panelX()
+	for y, line := range lines {
+		style := debugStyle
+		if strings.HasPrefix(line, fmt.Sprintf(" %04X", d.PC)) {
+			style = pcStyle
+		}
+		for x, r := range line {
+			t.s.SetContent(px+x, y, r, nil, style)
+		}
+	}
+	t.s.Show()
+}
+
+//panelX is the first column of the debug panel, right of the widest display the renderer draws
+func (t *Term) panelX() int {
+
+	cols, _ := t.render.size(128, 64)
+	return cols + 2
+}
+
+func (t *Term) clearPanel() {
+
+	cols, rows := t.s.Size()
+	for y := 0; y < rows; y++ {
+		for x := t.panelX(); x < cols; x++ {
+			t.s.SetContent(x, y, ' ', nil, tcell.StyleDefault)
+		}
+	}
+	t.s.Show()
+}
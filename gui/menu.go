@@ -1,23 +1,85 @@
 package gui
 
 import (
+	"fmt"
+	"os"
 	"sort"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/gdamore/tcell/v2"
+	"github.com/makoto126/term-atari/library"
+	"github.com/makoto126/term-atari/romdb"
 )
 
-//SelectRom show a menu for rom select
-func SelectRom(romList []string) string {
+const (
+	//listWidth is the width of the ROM list, the details of the selected ROM being right of it
+	listWidth = 56
+	//labelWidth is the most the list shows of the label of a ROM's source
+	labelWidth = 20
+	//descLines is how many lines of the description of the selected ROM the menu shows
+	descLines = 8
+)
+
+//help are the lines at the bottom of the menu
+var help = []string{
+	"Keys for Menu: ↑, ↓, PgUp, PgDn, Home, End, ESC, ENTER, TAB favorite, type to search",
+	"Keys for Game: 1, 2, 3, 4, q, w, e, r, a, s, d, f, z, x, c, v",
+	"Save states: F1-F4 save to slot 1-4, F5-F8 load from slot 1-4",
+	"Rewind: hold Backspace",
+	"Debug: F9 pause/resume, F10 step, F11 breakpoint at PC, F12 panel",
+}
+
+var (
+	labelStyle  = tcell.StyleDefault.Foreground(tcell.ColorGray)
+	searchStyle = tcell.StyleDefault.Foreground(tcell.ColorYellow)
+)
+
+type (
+	//entry is a line of the menu
+	entry struct {
+		name string
+		rom  library.Rom
+		//browse is set for the entry opening the file browser
+		browse bool
+		//info are the details shown when the entry is selected, looked up the first time it is
+		info []string
+		//data is the ROM and hash its romdb hash, the key of its stats
+		data []byte
+		hash string
+	}
+
+	//picker is the state of a menu
+	picker struct {
+		entries []entry
+		stats   *library.Stats
+		note    string
+		query   string
+		//shown are the indexes of the entries matching query, the best match first
+		shown []int
+		//recent ranks the hashes of the ROMs played last, 0 being the last one
+		recent   map[string]int
+		selected int
+		top      int
+	}
+)
+
+//SelectRom show a menu for rom select.
+//It lists the ROMs of lib with the label of the source each comes from,
+//the details db has on the selected one, and a file browser for ROMs anywhere on disk.
+//The favorites in stats come first, marked with a *, then the ROMs played last, marked with a +.
+//ok is false if the player quit.
+func SelectRom(lib library.RomSource, db romdb.DB, stats *library.Stats) (rom library.Rom, ok bool) {
 
 	s, err := tcell.NewScreen()
 	if err != nil {
-		return ""
+		return rom, false
 	}
 	defer s.Fini()
 
 	if err := s.Init(); err != nil {
-		return ""
+		return rom, false
 	}
 
 	s.SetStyle(
@@ -26,73 +88,427 @@ func SelectRom(romList []string) string {
 			Background(tcell.ColorBlack),
 	)
 
-	sort.Strings(romList)
-
-	selected := 0
-	move := make(chan struct{})
-	done := make(chan struct{})
-	go func() {
-		move <- struct{}{}
-
-		for {
-			ev := s.PollEvent()
-			switch ev := ev.(type) {
-			case *tcell.EventKey:
-				switch ev.Key() {
-				case tcell.KeyEscape:
-					selected = -1
-					close(done)
-					return
-				case tcell.KeyEnter:
-					close(done)
-					return
-				case tcell.KeyUp, tcell.KeyRight:
-					if selected > 0 {
-						selected--
-						move <- struct{}{}
-					}
-				case tcell.KeyDown, tcell.KeyLeft:
-					if selected < len(romList)-1 {
-						selected++
-						move <- struct{}{}
-					}
-				}
-			case *tcell.EventResize:
-				s.Sync()
+	m := newPicker(lib, stats)
+	m.entries = append(m.entries, entry{name: "Browse files...", browse: true})
+	m.filter()
+
+	for {
+		i := m.run(s, db)
+		if i == -1 {
+			return rom, false
+		}
+		e := m.entries[i]
+		if !e.browse {
+			return e.rom, true
+		}
+
+		path := browse(s, ".")
+		if path == "" {
+			continue
+		}
+		if !library.IsArchive(path) {
+			var size int64
+			if fi, err := os.Stat(path); err == nil {
+				size = fi.Size()
 			}
+			return library.Rom{Name: path, Size: size, Source: library.Files(path)}, true
+		}
+
+		//an archive gets a menu of its own, Escape going back to the main one
+		src, err := library.Archive(path)
+		pack := &picker{stats: stats}
+		if err == nil {
+			pack = newPicker(src, stats)
+		} else {
+			pack.note = err.Error()
+		}
+		pack.filter()
+		if i := pack.run(s, db); i >= 0 {
+			return pack.entries[i].rom, true
+		}
+	}
+}
+
+//newPicker makes a menu of the ROMs of lib, noting the error listing them if any.
+//It reads every ROM up front, their stats being kept by hash.
+func newPicker(lib library.RomSource, stats *library.Stats) *picker {
+
+	p := &picker{stats: stats}
+	roms, err := lib.List()
+	if err != nil {
+		p.note = err.Error()
+	}
+	for _, r := range roms {
+		e := entry{name: r.Name, rom: r}
+		if data, err := r.Open(); err == nil {
+			e.data, e.hash = data, romdb.Hash(data)
+		}
+		p.entries = append(p.entries, e)
+	}
+	return p
+}
+
+//filter shows the entries matching the query: the favorites first, then the ROMs played last,
+//the last one first, then the others, each group sorted by how well they match.
+//The file browser always comes last.
+func (p *picker) filter() {
+
+	type match struct {
+		i, group, score int
+	}
+	p.recent = make(map[string]int)
+	for n, h := range p.stats.Recent() {
+		p.recent[h] = n
+	}
+
+	var matches []match
+	var browse []int
+	for i, e := range p.entries {
+		if e.browse {
+			browse = append(browse, i)
+			continue
+		}
+		score, ok := fuzzy(p.query, e.name+" "+e.rom.Source.Label())
+		if !ok {
+			continue
 		}
-	}()
+		group := 1 + len(p.recent)
+		if p.stats.Favorite(e.hash) {
+			group = 0
+		} else if n, ok := p.recent[e.hash]; ok {
+			group = 1 + n
+		}
+		matches = append(matches, match{i, group, score})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].group != matches[j].group {
+			return matches[i].group < matches[j].group
+		}
+		return matches[i].score > matches[j].score
+	})
+
+	p.shown = p.shown[:0]
+	for _, m := range matches {
+		p.shown = append(p.shown, m.i)
+	}
+	p.shown = append(p.shown, browse...)
+	p.selected, p.top = 0, 0
+}
+
+//run lets the player pick an entry, returning its index or -1.
+//Every event redraws the menu, including the interrupts telling a thumbnail is ready.
+func (p *picker) run(s tcell.Screen, db romdb.DB) int {
 
-outer:
 	for {
-		select {
-		case <-done:
-			close(move)
-			break outer
-		case <-move:
-			for i, name := range romList {
-				s.SetContent(0, i, 0,
-					[]rune(strings.Split(name, "/")[1]),
-					tcell.StyleDefault.Reverse(i == selected),
-				)
+		p.draw(s, db)
+
+		_, h := s.Size()
+		rows := listRows(h)
+		switch ev := s.PollEvent().(type) {
+		case *tcell.EventKey:
+			switch ev.Key() {
+			case tcell.KeyEscape:
+				if p.query == "" {
+					return -1
+				}
+				p.query = ""
+				p.filter()
+			case tcell.KeyEnter:
+				if len(p.shown) > 0 {
+					return p.shown[p.selected]
+				}
+			case tcell.KeyUp:
+				p.move(-1)
+			case tcell.KeyDown:
+				p.move(1)
+			case tcell.KeyPgUp:
+				p.move(-rows)
+			case tcell.KeyPgDn:
+				p.move(rows)
+			case tcell.KeyHome:
+				p.move(-len(p.shown))
+			case tcell.KeyEnd:
+				p.move(len(p.shown))
+			case tcell.KeyTab:
+				p.toggleFavorite()
+			case tcell.KeyBackspace, tcell.KeyBackspace2:
+				if p.query != "" {
+					q := []rune(p.query)
+					p.query = string(q[:len(q)-1])
+					p.filter()
+				}
+			case tcell.KeyRune:
+				p.query += string(ev.Rune())
+				p.filter()
+			}
+		case *tcell.EventResize:
+			s.Sync()
+		}
+	}
+}
+
+func (p *picker) isRecent(hash string) bool {
+	_, ok := p.recent[hash]
+	return ok
+}
+
+//toggleFavorite makes the selected ROM a favorite or no longer one, keeping it selected
+func (p *picker) toggleFavorite() {
+
+	if len(p.shown) == 0 {
+		return
+	}
+	i := p.shown[p.selected]
+	e := &p.entries[i]
+	if e.hash == "" {
+		return
+	}
+	if err := p.stats.ToggleFavorite(e.hash); err != nil {
+		p.note = err.Error()
+	}
+	e.info = nil
+
+	p.filter()
+	for n, j := range p.shown {
+		if j == i {
+			p.selected = n
+		}
+	}
+}
+
+//move the selection by n entries, stopping at either end
+func (p *picker) move(n int) {
+
+	p.selected += n
+	if p.selected > len(p.shown)-1 {
+		p.selected = len(p.shown) - 1
+	}
+	if p.selected < 0 {
+		p.selected = 0
+	}
+}
+
+//listRows is how many entries fit on a screen h rows high
+func listRows(h int) int {
+
+	rows := h - len(help) - 2
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+func (p *picker) draw(s tcell.Screen, db romdb.DB) {
+
+	w, h := s.Size()
+	rows := listRows(h)
+	if p.selected < p.top {
+		p.top = p.selected
+	}
+	if p.selected >= p.top+rows {
+		p.top = p.selected - rows + 1
+	}
+
+	s.Clear()
+	puts(s, 0, 0, "Search: "+p.query+"_", searchStyle)
+	if len(p.shown) == 0 {
+		puts(s, 0, 1, "no ROM matches", labelStyle)
+	}
+
+	for y := 0; y < rows && p.top+y < len(p.shown); y++ {
+		i := p.top + y
+		e := p.entries[p.shown[i]]
+		label, mark := "", "  "
+		if !e.browse {
+			label = clip(e.rom.Source.Label(), labelWidth)
+			switch {
+			case p.stats.Favorite(e.hash):
+				mark = "* "
+			case p.isRecent(e.hash):
+				mark = "+ "
+			}
+		}
+		name := mark + clip(e.name, listWidth-len(label)-4)
+		line := fmt.Sprintf("%-*s", listWidth-len(label)-1, name)
+		puts(s, 0, 1+y, line, tcell.StyleDefault.Reverse(i == p.selected))
+		puts(s, listWidth-len(label), 1+y, label, labelStyle.Reverse(i == p.selected))
+	}
+
+	if len(p.shown) > 0 {
+		e := &p.entries[p.shown[p.selected]]
+		if e.info == nil {
+			e.info = details(e, db, p.stats)
+		}
+		y := 1
+		if e.data != nil && !library.IsSource(e.name) && w-listWidth-2 >= thumbWidth && rows > thumbHeight {
+			if d, ok := thumbs.get(s, e.data, db); ok {
+				drawThumb(s, listWidth+2, y, d)
+			}
+			y += thumbHeight + 1
+		}
+		for _, line := range e.info {
+			for _, l := range wrap(line, w-listWidth-2) {
+				if y > rows {
+					break
+				}
+				puts(s, listWidth+2, y, l, tcell.StyleDefault)
+				y++
+			}
+		}
+	}
+
+	y := h - len(help)
+	if p.note != "" {
+		puts(s, 0, y-1, p.note, tcell.StyleDefault.Foreground(tcell.ColorRed))
+	}
+	for i, line := range help {
+		puts(s, 0, y+i, line, helpStyle)
+	}
+	s.Show()
+}
+
+//details describes the ROM of an entry, from db, the text file that came with it
+//and what stats remember of playing it
+func details(e *entry, db romdb.DB, stats *library.Stats) []string {
+
+	if e.browse {
+		return []string{"Pick a ROM, a ROM pack or a source file anywhere on disk"}
+	}
+
+	r := e.rom
+	info := []string{r.Name, "from " + r.Source.Label() + ", " + formatSize(r.Size)}
+	if stats.Favorite(e.hash) {
+		info = append(info, "favorite")
+	}
+	if pl := stats.Plays(e.hash); pl != nil {
+		times := "times"
+		if pl.Count == 1 {
+			times = "time"
+		}
+		info = append(info, fmt.Sprintf("played %d %s for %v, last on %s",
+			pl.Count, times, pl.Time.Round(time.Second), pl.Last.Format("2006-01-02")))
+	}
+	info = append(info, "")
+	desc := r.Description
+
+	data := e.data
+	if data == nil {
+		var err error
+		if data, err = r.Open(); err != nil {
+			return append(info, err.Error())
+		}
+	}
+	if en, ok := db.Lookup(data); ok {
+		info = append(info, en.Title)
+		if len(en.Authors) > 0 {
+			info = append(info, "by "+strings.Join(en.Authors, ", "))
+		}
+		if en.Release != "" {
+			info = append(info, "released "+en.Release)
+		}
+		info = append(info, "for "+en.Platform.Name, "")
+
+		if len(en.Keys) > 0 {
+			info = append(info, "Keys")
+			names := make([]string, 0, len(en.Keys))
+			for name := range en.Keys {
+				names = append(names, name)
 			}
+			sort.Strings(names)
+			for _, name := range names {
+				info = append(info, " "+keyHelp(name, en.Keys[name]))
+			}
+			info = append(info, "")
+		}
+		if desc == "" {
+			desc = en.Description
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(desc), "\n")
+	for i := 0; i < len(lines) && i < descLines; i++ {
+		info = append(info, strings.TrimRight(lines[i], "\r"))
+	}
+	return info
+}
+
+//keyHelp tells which keys play a named control bound to the CHIP-8 key k
+func keyHelp(name string, k byte) string {
+
+	var keys []string
+	if key, ok := controls[name]; ok {
+		keys = append(keys, tcell.KeyNames[key])
+	}
+	for r, v := range keymap {
+		if v == k {
+			keys = append(keys, string(r))
+		}
+	}
+	return fmt.Sprintf("%-8s %-12s (%X)", name, strings.Join(keys, " or "), k)
+}
 
-			s.SetContent(0, len(romList)+1, 0,
-				[]rune("Keys for Menu: ↑，↓，ESC, ENTER"),
-				tcell.StyleDefault.Foreground(tcell.ColorGreen),
-			)
-			s.SetContent(0, len(romList)+2, 0,
-				[]rune("Keys for Game: 1，2，3, 4, q, w, e, r, a, s, d, f, z, x, c, v"),
-				tcell.StyleDefault.Foreground(tcell.ColorGreen),
-			)
+//fuzzy scores how well query matches s as a subsequence, ignoring case.
+//Runs of consecutive characters and matches at the start of words score higher.
+func fuzzy(query, s string) (int, bool) {
+
+	q := []rune(strings.ToLower(query))
+	if len(q) == 0 {
+		return 0, true
+	}
 
-			s.Show()
+	score, j := 0, 0
+	prev := -2
+	rs := []rune(strings.ToLower(s))
+	for i, r := range rs {
+		if r != q[j] {
+			continue
+		}
+		score++
+		if i == prev+1 {
+			score += 3
+		}
+		if i == 0 || !unicode.IsLetter(rs[i-1]) && !unicode.IsDigit(rs[i-1]) {
+			score += 2
+		}
+		prev = i
+		if j++; j == len(q) {
+			return score, true
 		}
 	}
+	return 0, false
+}
 
-	if selected == -1 {
+//clip cuts s to n runes
+func clip(s string, n int) string {
+
+	rs := []rune(s)
+	if n < 1 {
 		return ""
 	}
+	if len(rs) > n {
+		return string(rs[:n-1]) + "…"
+	}
+	return s
+}
+
+//wrap breaks a line into lines of at most width runes, at spaces where it can
+func wrap(line string, width int) []string {
 
-	return romList[selected]
+	if width < 1 {
+		return nil
+	}
+	var out []string
+	rs := []rune(line)
+	for len(rs) > width {
+		cut := width
+		for i := width; i > 0; i-- {
+			if rs[i] == ' ' {
+				cut = i
+				break
+			}
+		}
+		out = append(out, string(rs[:cut]))
+		rs = []rune(strings.TrimLeft(string(rs[cut:]), " "))
+	}
+	return append(out, string(rs))
 }
@@ -0,0 +1,238 @@
+package gui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+//the items of the pause menu
+const (
+	itemResume = iota
+	itemReset
+	itemSave
+	itemLoad
+	itemKeys
+	itemSpeed
+	itemQuit
+	items
+)
+
+//slots is the number of save state slots the pause menu picks from
+const slots = 4
+
+var menuStyle = tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorNavy)
+
+//pauseMenu is the menu Escape opens over the suspended game
+type pauseMenu struct {
+	item int
+	slot int
+	//keys is set while the key bindings are shown instead of the items
+	keys bool
+	//box is where the menu was last drawn, to be cleared
+	x, y, w, h int
+}
+
+//openMenu suspends the game and shows the pause menu over it
+func (t *Term) openMenu() {
+
+	t.chip8.Suspend()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.menu = &pauseMenu{slot: 1}
+	t.drawMenu()
+}
+
+//menuKey handles a key while the pause menu is open, reporting whether the player quits.
+//Closing the menu goes on with the game.
+func (t *Term) menuKey(ev *tcell.EventKey) bool {
+
+	t.mu.Lock()
+	quit, done, act := t.menuAction(ev)
+	t.clearMenu()
+	if done {
+		t.menu = nil
+		t.s.Show()
+	} else {
+		t.drawMenu()
+	}
+	t.mu.Unlock()
+
+	//the machine is told unlocked, as it draws on the screen while taking it
+	if act != nil {
+		act()
+	}
+	if done {
+		t.chip8.Continue()
+	}
+	return quit
+}
+
+//menuAction updates the pause menu for a key, reporting whether the player quits or closed the menu,
+//and returning what to tell the machine
+func (t *Term) menuAction(ev *tcell.EventKey) (quit, done bool, act func()) {
+
+	m := t.menu
+	if m.keys {
+		m.keys = false
+		return false, false, nil
+	}
+
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		return false, true, nil
+	case tcell.KeyUp:
+		m.item = (m.item + items - 1) % items
+	case tcell.KeyDown:
+		m.item = (m.item + 1) % items
+	case tcell.KeyLeft, tcell.KeyRight:
+		step := 1
+		if ev.Key() == tcell.KeyLeft {
+			step = -1
+		}
+		switch m.item {
+		case itemSave, itemLoad:
+			m.slot = (m.slot+slots-1+step)%slots + 1
+		case itemSpeed:
+			if s := t.speed + step; s >= 0 && s < len(speeds) {
+				t.speed = s
+				return false, false, func() {
+					t.chip8.SetSpeed(speeds[s])
+				}
+			}
+		}
+	case tcell.KeyEnter:
+		slot := m.slot
+		switch m.item {
+		case itemQuit:
+			return true, false, nil
+		case itemReset:
+			return false, true, t.chip8.Reset
+		case itemSave:
+			if t.save != nil {
+				return false, false, func() {
+					t.save(slot)
+				}
+			}
+		case itemLoad:
+			if t.load != nil {
+				return false, true, func() {
+					t.load(slot)
+				}
+			}
+			return false, true, nil
+		case itemKeys:
+			m.keys = true
+		default:
+			return false, true, nil
+		}
+	}
+	return false, false, nil
+}
+
+//menuLines are the lines of the pause menu, or of the key bindings it shows
+func (t *Term) menuLines() []string {
+
+	m := t.menu
+	if m.keys {
+		lines := []string{
+			"CHIP-8   keyboard",
+			"1 2 3 C  1 2 3 4",
+			"4 5 6 D  q w e r",
+			"7 8 9 E  a s d f",
+			"A 0 B F  z x c v",
+			"",
+		}
+		var bound []string
+		for k, b := range t.bound {
+			bound = append(bound, fmt.Sprintf("%X        %s", b, tcell.KeyNames[k]))
+		}
+		sort.Strings(bound)
+		if bound != nil {
+			lines = append(append(lines, bound...), "")
+		}
+		return append(lines,
+			"p pause, n next frame",
+			"- = speed, [ ] cycles",
+			"F1-F4 save, F5-F8 load",
+			"Backspace rewind",
+			"F9-F12 debugger",
+		)
+	}
+
+	lines := make([]string, items)
+	lines[itemResume] = "Resume"
+	lines[itemReset] = "Reset"
+	lines[itemSave] = fmt.Sprintf("Save state    < %d >", m.slot)
+	lines[itemLoad] = fmt.Sprintf("Load state    < %d >", m.slot)
+	lines[itemKeys] = "Key bindings"
+	lines[itemSpeed] = fmt.Sprintf("Speed  < %s >", speedName(speeds[t.speed]))
+	lines[itemQuit] = "Quit to menu"
+	return lines
+}
+
+//drawMenu draws the pause menu in a box centered over the display,
+//or below it for renderers that draw over the cells
+func (t *Term) drawMenu() {
+
+	m := t.menu
+	lines := t.menuLines()
+	m.w, m.h = 0, len(lines)+2
+	for _, l := range lines {
+		if n := len([]rune(l)) + 4; n > m.w {
+			m.w = n
+		}
+	}
+
+	cols, rows := t.render.size(t.shown.Size())
+	if _, ok := t.render.(presenter); ok {
+		m.x, m.y = 0, rows+2
+	} else {
+		m.x, m.y = (cols-m.w)/2, (rows-m.h)/2
+	}
+	//an even column never splits the double width cells of the full renderer
+	if m.x &^= 1; m.x < 0 {
+		m.x = 0
+	}
+	if m.y < 0 {
+		m.y = 0
+	}
+
+	for y := 0; y < m.h; y++ {
+		for x := 0; x < m.w; x++ {
+			t.s.SetContent(m.x+x, m.y+y, ' ', nil, menuStyle)
+		}
+	}
+	for i, l := range lines {
+		style := menuStyle
+		if !m.keys && i == m.item {
+			style = style.Reverse(true)
+		}
+		for x, r := range []rune(l) {
+			t.s.SetContent(m.x+2+x, m.y+1+i, r, nil, style)
+		}
+	}
+	t.s.Show()
+}
+
+//clearMenu restores what the pause menu covered: the display, the status line and the debug panel
+func (t *Term) clearMenu() {
+
+	m := t.menu
+	for y := m.y; y < m.y+m.h; y++ {
+		for x := m.x; x < m.x+m.w; x++ {
+			t.s.SetContent(x, y, ' ', nil, tcell.StyleDefault)
+		}
+	}
+	w, h := t.shown.Size()
+	for i := 0; i < w; i++ {
+		for j := 0; j < h; j++ {
+			t.fill(i, j)
+		}
+	}
+	t.drawStatus()
+	if t.panel {
+		t.drawPanel()
+	}
+}
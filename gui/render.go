@@ -0,0 +1,104 @@
+package gui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/makoto126/term-atari/vm"
+)
+
+type (
+	//Renderer draws the display on the cells of the terminal
+	Renderer interface {
+		//size is how many cells a display of w x h pixels takes
+		size(w, h int) (cols, rows int)
+		//fill redraws the cells showing pixel i, j of d
+		fill(s tcell.Screen, d *vm.Display, i, j int)
+	}
+
+	//full draws every pixel as a whole cell, two cells wide in low resolution
+	//so pixels stay square. 128x64 high resolution needs 64 rows.
+	full struct{}
+
+	//half draws two rows of pixels in each cell as the upper and lower half of a block.
+	//Low resolution pixels take two columns and both halves, so every mode fits in 128x32 cells.
+	half struct{}
+
+	//braille draws 2x4 pixels in each cell as the dots of a braille pattern,
+	//for small terminals: 64x32 takes 32x8 cells and 128x64 takes 64x16.
+	//A cell has a single color, that of all the planes its dots are set in.
+	braille struct{}
+)
+
+//brailleDots are the bits of the braille pattern dots, by row and column of the pixels of a cell
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+//Renderers make the renderers by name. scale is the size in screen pixels
+//of a pixel of the display, for the sixel and kitty renderers drawing bitmaps.
+var Renderers = map[string]func(scale int) Renderer{
+	"full":    func(int) Renderer { return full{} },
+	"half":    func(int) Renderer { return half{} },
+	"braille": func(int) Renderer { return braille{} },
+	"sixel":   func(scale int) Renderer { return bitmap{EncodeSixel, scale} },
+	"kitty":   func(scale int) Renderer { return bitmap{EncodeKitty, scale} },
+}
+
+func (full) size(w, h int) (int, int) {
+	return 128, h
+}
+
+func (full) fill(s tcell.Screen, d *vm.Display, i, j int) {
+
+	style := tcell.StyleDefault
+	if c := d.Pixel(i, j); c != 0 {
+		style = style.Background(palette[c])
+	}
+
+	if w, _ := d.Size(); w == 64 {
+		s.SetContent(2*i, j, rune('　'), nil, style)
+	} else {
+		s.SetContent(i, j, ' ', nil, style)
+	}
+}
+
+func (half) size(w, h int) (int, int) {
+	return 128, 32
+}
+
+func (half) fill(s tcell.Screen, d *vm.Display, i, j int) {
+
+	//a low resolution pixel is 2x2 halves of blocks
+	w, _ := d.Size()
+	scale := 128 / w
+	for x := i * scale; x < (i+1)*scale; x++ {
+		for y := j * scale / 2; y <= ((j+1)*scale-1)/2; y++ {
+			top := d.Pixel(x/scale, 2*y/scale)
+			bottom := d.Pixel(x/scale, (2*y+1)/scale)
+			style := tcell.StyleDefault.Foreground(palette[top]).Background(palette[bottom])
+			s.SetContent(x, y, '▀', nil, style)
+		}
+	}
+}
+
+func (braille) size(w, h int) (int, int) {
+	return w / 2, h / 4
+}
+
+func (braille) fill(s tcell.Screen, d *vm.Display, i, j int) {
+
+	x, y := i/2, j/4
+	var dots rune
+	var c int
+	for row := range brailleDots {
+		for col, dot := range brailleDots[row] {
+			if p := d.Pixel(2*x+col, 4*y+row); p != 0 {
+				dots |= dot
+				c |= p
+			}
+		}
+	}
+	s.SetContent(x, y, 0x2800+dots, nil, tcell.StyleDefault.Foreground(palette[c]))
+}
@@ -0,0 +1,112 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/makoto126/term-atari/vm"
+)
+
+//speeds are the steps of the slow motion and fast-forward hotkeys, from the slowest
+var speeds = []float64{0.25, 0.5, 1, 2, 4, vm.Unthrottled}
+
+//normalSpeed is the step of speeds running in real time
+const normalSpeed = 2
+
+//OnTickRate sets the handler told of the cycles per frame set with the [ and ] hotkeys
+func (t *Term) OnTickRate(f func(n int)) {
+	t.tickRate = f
+}
+
+//speedKey handles the speed hotkeys, reporting whether ev was one:
+//p pauses or resumes, n advances a frame while paused, - and = step through
+//slow motion, normal speed and fast-forward, [ and ] change the cycles per frame
+func (t *Term) speedKey(ev *tcell.EventKey) bool {
+
+	if t.chip8 == nil || ev.Key() != tcell.KeyRune {
+		return false
+	}
+
+	switch ev.Rune() {
+	case 'p':
+		t.togglePause()
+	case 'n':
+		t.chip8.FrameAdvance()
+	case '-':
+		if t.speed > 0 {
+			t.speed--
+			t.chip8.SetSpeed(speeds[t.speed])
+		}
+	case '=', '+':
+		if t.speed < len(speeds)-1 {
+			t.speed++
+			t.chip8.SetSpeed(speeds[t.speed])
+		}
+	case '[':
+		t.setTickRate(t.rate() - tickRateStep(t.rate()-1))
+	case ']':
+		t.setTickRate(t.rate() + tickRateStep(t.rate()))
+	default:
+		return false
+	}
+	return true
+}
+
+func (t *Term) togglePause() {
+	if t.debugView().Paused {
+		t.chip8.Resume()
+	} else {
+		t.chip8.Pause()
+	}
+}
+
+//rate is the cycles per frame last set with the hotkeys, else those the machine reported
+func (t *Term) rate() int {
+
+	if t.ticks == 0 {
+		return t.debugView().TickRate
+	}
+	return t.ticks
+}
+
+func (t *Term) setTickRate(n int) {
+
+	if n < 1 {
+		return
+	}
+	t.ticks = n
+	t.chip8.Do(func() {
+		t.chip8.SetTickRate(n)
+	})
+	if t.tickRate != nil {
+		t.tickRate(n)
+	}
+}
+
+//tickRateStep is how much [ and ] change n cycles per frame by, about an eighth
+func tickRateStep(n int) int {
+
+	if n < 8 {
+		return 1
+	}
+	return n / 8
+}
+
+//speedText describes the speed of the machine for the status line
+func speedText(d vm.Debug) string {
+
+	speed := speedName(d.Speed)
+	if d.Paused {
+		speed = "paused"
+	}
+	return fmt.Sprintf("%s  %d cycles/frame", speed, d.TickRate)
+}
+
+//speedName names a speed, as a factor of real time
+func speedName(speed float64) string {
+
+	if speed == vm.Unthrottled {
+		return "unthrottled"
+	}
+	return fmt.Sprintf("%gx", speed)
+}
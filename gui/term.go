@@ -1,15 +1,14 @@
 package gui
 
 import (
+	"sync"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
+	"github.com/makoto126/term-atari/vm"
 )
 
-const (
-	keyPressInterval = 80 * time.Millisecond
-	keyWaitInterval  = 10 * time.Millisecond
-)
+const keyPressInterval = 80 * time.Millisecond
 
 var keymap = map[rune]byte{
 	'1': 1,
@@ -30,14 +29,70 @@ var keymap = map[rune]byte{
 	'v': 15,
 }
 
+//controls are the terminal keys a ROM's named controls can be bound to
+var controls = map[string]tcell.Key{
+	"up":    tcell.KeyUp,
+	"down":  tcell.KeyDown,
+	"left":  tcell.KeyLeft,
+	"right": tcell.KeyRight,
+	"a":     tcell.KeyEnter,
+	"b":     tcell.KeyTab,
+}
+
+//palette colors a pixel by the planes it is set in (XO-CHIP)
+var palette = [4]tcell.Color{
+	tcell.ColorBlack,
+	tcell.ColorWhite,
+	tcell.ColorOrange,
+	tcell.ColorMaroon,
+}
+
 //Term is the atari gui
 type Term struct {
 	s tcell.Screen
 
-	ek  *tcell.EventKey
-	gfx [64][32]bool
+	//key is the last key pressed and ek its event, set on the event goroutine
+	//and read on the machine's, under keyMu apart from the screen lock so keys never wait for drawing
+	keyMu sync.Mutex
+	ek    *tcell.EventKey
+	key   byte
+
+	bound map[tcell.Key]byte
+
+	//shown is the display as last presented
+	shown  vm.Display
+	render Renderer
+	//frames passes the displays presented on to a presenter renderer
+	frames chan vm.Display
+
+	save     func(int)
+	load     func(int)
+	back     func()
+	tickRate func(int)
+
+	//msg is the message on the status line, left of the speed
+	msg string
+	//speed is the step of speeds set, ticks the cycles per frame set, 0 until one is
+	speed int
+	ticks int
+
+	//mu guards the screen and the state drawn on it: the display, the debug view and panel,
+	//the status line and the pause menu, which the machine and key events update at once.
+	//It also keeps the images of presenter renderers from landing in the middle of a screen update.
+	mu sync.Mutex
+	//menu is the pause menu, nil while the game runs
+	menu *pauseMenu
+
+	chip8     *vm.Chip8
+	panel     bool
+	view      vm.Debug
+	prompting bool
+	prompt    string
+	cmdErr    string
 
 	quit chan struct{}
+	//closing ends the screen once, whether the player quits or the game ends
+	closing sync.Once
 }
 
 //Init the Term
@@ -59,25 +114,68 @@ func (t *Term) Init() (<-chan struct{}, error) {
 	)
 
 	t.s = s
+	if t.render == nil {
+		t.render = full{}
+	}
+	t.speed = normalSpeed
 	t.quit = make(chan struct{})
+	if p, ok := t.render.(presenter); ok {
+		t.frames = make(chan vm.Display, 1)
+		go t.presentLoop(p)
+	}
 
 	go func() {
 		for {
 			ev := t.s.PollEvent()
+			if ev == nil {
+				//the screen was ended
+				return
+			}
 			switch ev := ev.(type) {
 			case *tcell.EventKey:
+				if t.menu != nil {
+					if t.menuKey(ev) {
+						t.fini()
+						return
+					}
+					break
+				}
+				if t.debugKey(ev) || t.speedKey(ev) {
+					break
+				}
 				switch ev.Key() {
 				case tcell.KeyEscape:
-					t.s.Fini()
-					close(t.quit)
+					if t.chip8 != nil {
+						t.openMenu()
+						break
+					}
+					t.fini()
 					return
 				case tcell.KeyRune:
-					if _, ok := keymap[ev.Rune()]; ok {
-						t.ek = ev
+					if k, ok := keymap[ev.Rune()]; ok {
+						t.press(k, ev)
+					}
+				case tcell.KeyF1, tcell.KeyF2, tcell.KeyF3, tcell.KeyF4:
+					if t.save != nil {
+						t.save(int(ev.Key()-tcell.KeyF1) + 1)
+					}
+				case tcell.KeyF5, tcell.KeyF6, tcell.KeyF7, tcell.KeyF8:
+					if t.load != nil {
+						t.load(int(ev.Key()-tcell.KeyF5) + 1)
+					}
+				case tcell.KeyBackspace, tcell.KeyBackspace2:
+					if t.back != nil {
+						t.back()
+					}
+				default:
+					if k, ok := t.bound[ev.Key()]; ok {
+						t.press(k, ev)
 					}
 				}
 			case *tcell.EventResize:
+				t.mu.Lock()
 				t.s.Sync()
+				t.mu.Unlock()
 			}
 		}
 	}()
@@ -85,78 +183,148 @@ func (t *Term) Init() (<-chan struct{}, error) {
 	return t.quit, nil
 }
 
+//Close ends the screen and the goroutines of the Term, as quitting does.
+//It may be called any number of times, before or after the player quits.
+func (t *Term) Close() {
+	t.fini()
+}
+
+//fini ends the screen and tells the Term quit, the first time it is called
+func (t *Term) fini() {
+	t.closing.Do(func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.s.Fini()
+		close(t.quit)
+	})
+}
+
+//press records a key press
+func (t *Term) press(k byte, ev *tcell.EventKey) {
+
+	t.keyMu.Lock()
+	defer t.keyMu.Unlock()
+	t.key, t.ek = k, ev
+}
+
 //IsPressed Impl
 func (t *Term) IsPressed(b byte) bool {
 
+	t.keyMu.Lock()
+	defer t.keyMu.Unlock()
 	if t.ek != nil {
-		return b == keymap[t.ek.Rune()] && t.ek.When().Add(keyPressInterval).After(time.Now())
+		return b == t.key && t.ek.When().Add(keyPressInterval).After(time.Now())
 	}
 
 	return false
 }
 
-//WaitKey Impl
-func (t *Term) WaitKey() byte {
+//Bind the arrow keys, Enter and Tab to a ROM's named controls
+func (t *Term) Bind(keys map[string]byte) {
 
-	now := time.Now()
-	for range time.Tick(keyWaitInterval) {
-		if t.ek != nil && t.ek.When().After(now) {
-			break
+	t.bound = make(map[tcell.Key]byte)
+	for name, k := range keys {
+		if key, ok := controls[name]; ok {
+			t.bound[key] = k
 		}
 	}
-	return keymap[t.ek.Rune()]
 }
 
-//Clear Impl
-func (t *Term) Clear() {
+//OnSlot sets the handlers for the save state hotkeys:
+//F1-F4 save to slots 1-4 and F5-F8 load from them
+func (t *Term) OnSlot(save, load func(slot int)) {
+	t.save, t.load = save, load
+}
+
+//OnRewind sets the handler for the rewind hotkey, Backspace,
+//which is called again for every key repeat while it is held
+func (t *Term) OnRewind(back func()) {
+	t.back = back
+}
+
+//Render sets how the display is drawn, one of Renderers, before Init
+func (t *Term) Render(r Renderer) {
+	t.render = r
+}
+
+//Status shows a message on the line below the display
+func (t *Term) Status(msg string) {
 
-	for i := 0; i < 64; i++ {
-		for j := 0; j < 32; j++ {
-			t.gfx[i][j] = false
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.msg = msg
+	t.drawStatus()
+}
+
+//drawStatus draws the status line: the message, and the speed at the right edge of the display
+func (t *Term) drawStatus() {
+
+	cols, _ := t.s.Size()
+	w, y := t.render.size(t.shown.Size())
+	for x := 0; x < cols && x < t.panelX(); x++ {
+		t.s.SetContent(x, y, ' ', nil, tcell.StyleDefault)
+	}
+
+	style := tcell.StyleDefault.Foreground(tcell.ColorGreen)
+	for x, r := range []rune(t.msg) {
+		t.s.SetContent(x, y, r, nil, style)
+	}
+	if t.chip8 != nil {
+		speed := []rune(speedText(t.view))
+		x := w - len(speed)
+		if min := len([]rune(t.msg)) + 2; x < min {
+			x = min
+		}
+		for i, r := range speed {
+			t.s.SetContent(x+i, y, r, nil, style)
 		}
 	}
-	t.s.Clear()
+	t.s.Show()
 }
 
-//Draw Impl
-func (t *Term) Draw(x, y int, mem []byte) byte {
+//Present Impl
+func (t *Term) Present(d *vm.Display) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	var flag byte
-	var xi, yj int
-	for j, m := range mem {
-		yj = y + j
-		if yj >= 32 {
-			break
+	prev := t.shown
+	t.shown = *d
+	if t.frames != nil {
+		select {
+		case <-t.frames:
+		default:
 		}
-		for i := 0; i < 8; i++ {
-			if m&(0x80>>uint(i)) != 0 {
-				xi = x + i
-				if xi >= 64 {
-					break
-				}
-				if t.gfx[xi][yj] {
-					t.gfx[xi][yj] = false
-					flag = 1
-				} else {
-					t.gfx[xi][yj] = true
-				}
-				t.fill(xi, yj)
+		t.frames <- t.shown
+		return
+	}
+
+	//switching resolution redraws everything, otherwise only the pixels that changed
+	w, h := d.Size()
+	all := false
+	if pw, _ := prev.Size(); pw != w {
+		t.s.Clear()
+		if t.panel {
+			t.drawPanel()
+		}
+		t.drawStatus()
+		all = true
+	}
+	for i := 0; i < w; i++ {
+		for j := 0; j < h; j++ {
+			if all || d.Pixel(i, j) != prev.Pixel(i, j) {
+				t.fill(i, j)
 			}
 		}
 	}
-
+	if t.menu != nil {
+		t.drawMenu()
+	}
 	t.s.Show()
-	return flag
 }
 
 func (t *Term) fill(i, j int) {
-
-	style := tcell.StyleDefault
-	if t.gfx[i][j] {
-		style = style.Background(tcell.ColorWhite)
-	}
-
-	t.s.SetContent(2*i, j, rune('　'), nil, style)
+	t.render.fill(t.s, &t.shown, i, j)
 }
 
 //Beep Impl
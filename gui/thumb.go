@@ -0,0 +1,128 @@
+package gui
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/makoto126/term-atari/romdb"
+	"github.com/makoto126/term-atari/vm"
+)
+
+const (
+	//thumbCycles is how many instructions a ROM runs before its thumbnail is taken
+	thumbCycles = 600
+	//thumbWidth and thumbHeight are the size of a thumbnail in cells,
+	//each cell showing two rows of the display shrunk to 32x16
+	thumbWidth  = 32
+	thumbHeight = 8
+)
+
+type (
+	//idle stands in for the screen, the keyboard and the speaker of the ROMs run off screen.
+	//It taps key 0 over and over, pressed and released on every other look,
+	//so key waits end at once and get many ROMs past their title screen.
+	idle struct {
+		down bool
+	}
+
+	//thumbCache holds the thumbnails of ROMs by hash, nil while one is being taken
+	thumbCache struct {
+		sync.Mutex
+		displays map[string]*vm.Display
+	}
+)
+
+//thumbs are the thumbnails taken since the program started
+var thumbs = &thumbCache{displays: make(map[string]*vm.Display)}
+
+//Present Impl
+func (*idle) Present(*vm.Display) {}
+
+//Beep Impl
+func (*idle) Beep() {}
+
+//IsPressed Impl
+func (i *idle) IsPressed(k byte) bool {
+
+	if k != 0 {
+		return false
+	}
+	i.down = !i.down
+	return i.down
+}
+
+//get returns the thumbnail of a ROM, taking it in the background the first time it is asked for.
+//s gets an interrupt event once the thumbnail is ready; ok is false until then.
+func (t *thumbCache) get(s tcell.Screen, rom []byte, db romdb.DB) (d *vm.Display, ok bool) {
+
+	hash := romdb.Hash(rom)
+
+	t.Lock()
+	defer t.Unlock()
+	if d, taken := t.displays[hash]; taken {
+		return d, d != nil
+	}
+	t.displays[hash] = nil
+
+	go func() {
+		d := thumbnail(rom, db)
+		t.Lock()
+		t.displays[hash] = d
+		t.Unlock()
+		s.PostEvent(tcell.NewEventInterrupt(nil))
+	}()
+	return nil, false
+}
+
+//thumbnail runs a ROM off screen for thumbCycles instructions and returns its display
+func thumbnail(rom []byte, db romdb.DB) *vm.Display {
+
+	//ROMs not in the database run without quirks, as by lookup in main
+	var q vm.Quirks
+	entry, found := db.Lookup(rom)
+	if found {
+		q = entry.Quirks
+	}
+
+	c := new(vm.Chip8)
+	in := new(idle)
+	c.Init(in, in, in, nil, q)
+	if found {
+		c.SetTickRate(entry.TickRate)
+	}
+	c.Load(bytes.NewReader(rom))
+	//a ROM going astray only ends its preview early
+	c.Run(thumbCycles)
+	d := c.Display()
+	return &d
+}
+
+//drawThumb draws a thumbnail from column x of row y, shrinking the display
+//so each cell shows two rows of it as the halves of a block
+func drawThumb(s tcell.Screen, x, y int, d *vm.Display) {
+
+	w, _ := d.Size()
+	scale := w / thumbWidth
+	for cy := 0; cy < thumbHeight; cy++ {
+		for cx := 0; cx < thumbWidth; cx++ {
+			top := shrunk(d, cx, 2*cy, scale)
+			bottom := shrunk(d, cx, 2*cy+1, scale)
+			style := tcell.StyleDefault.Foreground(palette[top]).Background(palette[bottom])
+			s.SetContent(x+cx, y+cy, '▀', nil, style)
+		}
+	}
+}
+
+//shrunk is the color of a pixel of the display shrunk by scale,
+//a pixel being set if any of the pixels it stands for is
+func shrunk(d *vm.Display, x, y, scale int) int {
+
+	var c int
+	for i := x * scale; i < (x+1)*scale; i++ {
+		for j := y * scale; j < (y+1)*scale; j++ {
+			c |= d.Pixel(i, j)
+		}
+	}
+	return c
+}
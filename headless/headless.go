@@ -0,0 +1,165 @@
+//Package headless runs vm.Chip8 without a terminal or clocks, as fast as it goes,
+//for tests and batch jobs. Input comes from a script of key presses by frame,
+//and the display is kept in memory, to be dumped as text.
+package headless
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/makoto126/term-atari/vm"
+)
+
+//dumpChars are the characters Dump writes for pixels, by the planes they are set in
+const dumpChars = ".#+@"
+
+type (
+	//Press holds Key down for Frames frames from frame Frame on, counting from 0
+	Press struct {
+		Frame  int
+		Frames int
+		Key    byte
+	}
+
+	//Machine is a vm.Chip8 with an in-memory display, scripted input and no sound
+	Machine struct {
+		chip8   *vm.Chip8
+		display vm.Display
+		script  []Press
+		//frame is the frame running, cycle how many of its instructions ran
+		frame int
+		cycle int
+	}
+)
+
+//New makes a Machine running rom with quirks q and input from script.
+//Random numbers are seeded from a virtual clock, so that runs are deterministic.
+//tickRate is the number of instructions per frame, 0 for the default 500Hz clock.
+func New(rom []byte, q vm.Quirks, tickRate int, script []Press) (*Machine, error) {
+
+	m := &Machine{chip8: new(vm.Chip8), script: script}
+	m.chip8.Init(m, m, m, nil, q)
+	m.chip8.SetClock(vm.NewVirtualClock(time.Unix(0, 0)))
+	if tickRate > 0 {
+		m.chip8.SetTickRate(tickRate)
+	}
+	if err := m.chip8.Load(bytes.NewReader(rom)); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+//RunCycles executes n instructions, stopping early if the program exits or fails
+func (m *Machine) RunCycles(n int) error {
+
+	perFrame := m.chip8.FrameCycles()
+	for n > 0 && !m.chip8.Exited() {
+		k := perFrame - m.cycle
+		if k > n {
+			k = n
+		}
+		if err := m.chip8.Run(k); err != nil {
+			return err
+		}
+		n -= k
+		if m.cycle += k; m.cycle == perFrame {
+			m.frame++
+			m.cycle = 0
+		}
+	}
+	return nil
+}
+
+//RunFrames executes instructions until n more frames ended, the one under way counting as the first
+func (m *Machine) RunFrames(n int) error {
+	return m.RunCycles(n*m.chip8.FrameCycles() - m.cycle)
+}
+
+//Frame is the number of frames run
+func (m *Machine) Frame() int {
+	return m.frame
+}
+
+//Exited tells whether the program ran the exit instruction
+func (m *Machine) Exited() bool {
+	return m.chip8.Exited()
+}
+
+//Display is the display as last presented
+func (m *Machine) Display() *vm.Display {
+	return &m.display
+}
+
+//Present Impl
+func (m *Machine) Present(d *vm.Display) {
+	m.display = *d
+}
+
+//Beep Impl
+func (m *Machine) Beep() {}
+
+//IsPressed Impl
+func (m *Machine) IsPressed(k byte) bool {
+
+	for _, p := range m.script {
+		if p.Key == k && p.Frame <= m.frame && m.frame < p.Frame+p.Frames {
+			return true
+		}
+	}
+	return false
+}
+
+//ParseScript parses a script of comma separated presses, each written
+//frame:key or frame:key:frames with the key in hex, held for 1 frame by default.
+//"60:5:10, 90:A" holds key 5 down for frames 60 to 69 and key A for frame 90.
+func ParseScript(s string) ([]Press, error) {
+
+	var script []Press
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f == "" {
+			continue
+		}
+		parts := strings.Split(f, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("bad press %q, want frame:key[:frames]", f)
+		}
+
+		p := Press{Frames: 1}
+		var err error
+		if p.Frame, err = strconv.Atoi(parts[0]); err != nil || p.Frame < 0 {
+			return nil, fmt.Errorf("bad frame in %q", f)
+		}
+		k, err := strconv.ParseUint(parts[1], 16, 4)
+		if err != nil {
+			return nil, fmt.Errorf("bad key in %q", f)
+		}
+		p.Key = byte(k)
+		if len(parts) == 3 {
+			if p.Frames, err = strconv.Atoi(parts[2]); err != nil || p.Frames < 1 {
+				return nil, fmt.Errorf("bad frame count in %q", f)
+			}
+		}
+		script = append(script, p)
+	}
+	return script, nil
+}
+
+//Dump writes the display as text, a line per row and a character per pixel:
+//. for a clear pixel, # for one set in the first plane, + in the second and @ in both
+func Dump(w io.Writer, d *vm.Display) error {
+
+	bw := bufio.NewWriter(w)
+	width, height := d.Size()
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			bw.WriteByte(dumpChars[d.Pixel(x, y)])
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
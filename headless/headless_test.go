@@ -0,0 +1,67 @@
+package headless
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/makoto126/term-atari/vm"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestDumpBrix(t *testing.T) {
+
+	rom, err := os.ReadFile("../roms/brix.rom")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := New(rom, vm.Quirks{}, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.RunFrames(120); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Dump(&buf, m.Display()); err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", "brix.golden")
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != string(want) {
+		t.Errorf("frame 120 of brix:\n%s\nwant:\n%s", got, want)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 32 {
+		t.Errorf("dumped %d rows, want 32", lines)
+	}
+}
+
+func TestStackErrors(t *testing.T) {
+
+	for name, rom := range map[string][]byte{
+		"overflow":  {0x22, 0x00},
+		"underflow": {0x00, 0xEE},
+	} {
+		m, err := New(rom, vm.Quirks{}, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = m.RunFrames(10)
+		if err == nil || !strings.Contains(err.Error(), name) {
+			t.Errorf("stack %s: got error %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package library
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type (
+	//fsSource lists the ROM files of a file system, recursively
+	fsSource struct {
+		label string
+		fsys  fs.FS
+	}
+
+	//files is a list of ROM files on disk
+	files []string
+)
+
+//FS is a source of the ROM files in a file system, under the given label
+func FS(label string, fsys fs.FS) RomSource {
+	return &fsSource{label, fsys}
+}
+
+//Embedded is the source of the ROMs built into the binary
+func Embedded(fsys fs.FS) RomSource {
+	return FS("built-in", fsys)
+}
+
+//Dir is the source of the ROMs in a directory and its subdirectories
+func Dir(path string) (RomSource, error) {
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, &fs.PathError{Op: "open", Path: path, Err: errNotDir}
+	}
+	return FS(path, os.DirFS(path)), nil
+}
+
+//Zip is the source of the ROMs in a zip archive, which is read whole into memory
+func Zip(path string) (RomSource, error) {
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return FS(filepath.Base(path), zr), nil
+}
+
+//Files is the source of the ROM files at the given paths
+func Files(paths ...string) RomSource {
+	return files(paths)
+}
+
+func (s *fsSource) Label() string {
+	return s.label
+}
+
+func (s *fsSource) List() ([]Rom, error) {
+
+	var roms []Rom
+	texts := make(map[string]string)
+	err := fs.WalkDir(s.fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if hidden(path) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case isText(path) && fi.Size() <= maxDescription:
+			text, err := fs.ReadFile(s.fsys, path)
+			if err != nil {
+				return err
+			}
+			texts[path] = string(text)
+		case IsRom(path):
+			roms = append(roms, Rom{Name: path, Size: fi.Size(), Source: s})
+		}
+		return nil
+	})
+	describe(roms, texts)
+	sortRoms(roms)
+	return roms, err
+}
+
+func (s *fsSource) Open(name string) ([]byte, error) {
+	return fs.ReadFile(s.fsys, filepath.ToSlash(filepath.Clean(name)))
+}
+
+func sortRoms(roms []Rom) {
+	sort.Slice(roms, func(i, j int) bool {
+		return strings.ToLower(roms[i].Name) < strings.ToLower(roms[j].Name)
+	})
+}
+
+func (f files) Label() string {
+	return "file"
+}
+
+func (f files) List() ([]Rom, error) {
+
+	roms := make([]Rom, 0, len(f))
+	for _, path := range f {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return roms, err
+		}
+		roms = append(roms, Rom{Name: path, Size: fi.Size(), Source: f})
+	}
+	return roms, nil
+}
+
+//Open reads any file, so that sources can include files next to them
+func (f files) Open(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
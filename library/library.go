@@ -0,0 +1,235 @@
+//Package library gathers ROMs from where they are kept:
+//the set built into the binary, directories and archives on disk.
+package library
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type (
+	//RomSource is a collection of ROMs
+	RomSource interface {
+		//Label names the source in the menu, such as "built-in" or a directory
+		Label() string
+		//List lists the ROMs of the source
+		List() ([]Rom, error)
+		//Open reads a ROM by name
+		Open(name string) ([]byte, error)
+	}
+
+	//Rom is a ROM of a source
+	Rom struct {
+		//Name is the path of the ROM within its source
+		Name string
+		Size int64
+		//Description is the text file that came with the ROM, if any
+		Description string
+		//Source is where the ROM comes from
+		Source RomSource
+	}
+
+	//merged is the sources of a library one after the other
+	merged []RomSource
+)
+
+var errNotDir = errors.New("not a directory")
+
+//Exts are the file extensions of ROMs and of the sources term-atari builds ROMs from.
+//The empty one is for the many ROMs distributed without an extension, but for docNames.
+var Exts = []string{".ch8", ".c8", ".sc8", ".xo8", ".rom", ".8o", ".asm", ".s", ""}
+
+//SourceExts are the extensions among Exts of the assembly and Octo sources
+var SourceExts = []string{".8o", ".asm", ".s"}
+
+//docNames begin the names of the documents that come with ROMs, which are no ROMs without an extension either
+var docNames = []string{"readme", "license", "licence", "copying", "authors", "credits", "changelog", "notice"}
+
+//ArchiveExts are the file extensions of the archives Archive opens
+var ArchiveExts = []string{".zip", ".tar", ".tar.gz", ".tgz"}
+
+//maxDescription bounds the size of the description files read
+const maxDescription = 64 << 10
+
+//IsRom tells by its extension whether a file is a ROM
+func IsRom(name string) bool {
+
+	ext := strings.ToLower(filepath.Ext(name))
+	if ext == "" {
+		return !isDoc(name)
+	}
+	for _, e := range Exts {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+//isDoc tells by its name whether a file is a document such as a README or a LICENSE
+func isDoc(name string) bool {
+
+	base := strings.ToLower(filepath.Base(name))
+	for _, d := range docNames {
+		if strings.HasPrefix(base, d) {
+			return true
+		}
+	}
+	return false
+}
+
+//IsSource tells by its extension whether a file is a source to build a ROM from
+func IsSource(name string) bool {
+
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, e := range SourceExts {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+//IsArchive tells by its extension whether a file is an archive of ROMs
+func IsArchive(name string) bool {
+	return archiveExt(name) != ""
+}
+
+func archiveExt(name string) string {
+
+	name = strings.ToLower(name)
+	for _, e := range ArchiveExts {
+		if strings.HasSuffix(name, e) {
+			return e
+		}
+	}
+	return ""
+}
+
+//Archive is the source of the ROMs in a zip or tar archive, by its extension
+func Archive(path string) (RomSource, error) {
+
+	switch archiveExt(path) {
+	case ".zip":
+		return Zip(path)
+	case "":
+		return nil, fmt.Errorf("%s: not a zip or tar archive", path)
+	}
+	return Tar(path)
+}
+
+//describe gives each ROM the text file with the same name as its description, or else,
+//if it is the only ROM of its directory, the only text file there or its README.
+//texts holds the text files by path.
+func describe(roms []Rom, texts map[string]string) {
+
+	byStem := make(map[string]string)
+	byDir := make(map[string][]string)
+	for name, text := range texts {
+		byStem[strings.ToLower(strings.TrimSuffix(name, path.Ext(name)))] = text
+		byDir[path.Dir(name)] = append(byDir[path.Dir(name)], name)
+	}
+	romsIn := make(map[string]int)
+	for _, r := range roms {
+		romsIn[path.Dir(filepath.ToSlash(r.Name))]++
+	}
+
+	for i, r := range roms {
+		name := filepath.ToSlash(r.Name)
+		dir := path.Dir(name)
+		if text, ok := byStem[strings.ToLower(strings.TrimSuffix(name, path.Ext(name)))]; ok {
+			roms[i].Description = text
+		} else if romsIn[dir] == 1 {
+			roms[i].Description = texts[dirText(byDir[dir])]
+		}
+	}
+}
+
+//dirText picks the description of a directory among its text files:
+//the only one, or else the only README, "" if there is none
+func dirText(names []string) string {
+
+	if len(names) == 1 {
+		return names[0]
+	}
+	var readme string
+	for _, name := range names {
+		if strings.HasPrefix(strings.ToLower(path.Base(name)), "readme") {
+			if readme != "" {
+				return ""
+			}
+			readme = name
+		}
+	}
+	return readme
+}
+
+//isText tells whether a file is a description: a text or markdown file, or a README without an extension
+func isText(name string) bool {
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".txt", ".md":
+		return true
+	case "":
+		return strings.HasPrefix(strings.ToLower(path.Base(name)), "readme")
+	}
+	return false
+}
+
+//hidden tells whether a path has a dot file or directory in it
+func hidden(name string) bool {
+
+	for _, elem := range strings.Split(name, "/") {
+		if strings.HasPrefix(elem, ".") && elem != "." {
+			return true
+		}
+	}
+	return false
+}
+
+//Open reads the ROM
+func (r Rom) Open() ([]byte, error) {
+	return r.Source.Open(r.Name)
+}
+
+//Merge shows several sources as one. Its ROMs keep the source they come from,
+//and Open looks a name up in each source in turn.
+func Merge(sources ...RomSource) RomSource {
+	return merged(sources)
+}
+
+func (m merged) Label() string {
+	return "library"
+}
+
+func (m merged) List() ([]Rom, error) {
+
+	var roms []Rom
+	for _, src := range m {
+		list, err := src.List()
+		if err != nil {
+			return roms, err
+		}
+		roms = append(roms, list...)
+	}
+	return roms, nil
+}
+
+func (m merged) Open(name string) ([]byte, error) {
+
+	var err error
+	for _, src := range m {
+		var data []byte
+		if data, err = src.Open(name); err == nil {
+			return data, nil
+		}
+	}
+	if err == nil {
+		err = fs.ErrNotExist
+	}
+	return nil, err
+}
@@ -0,0 +1,89 @@
+package library
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsRom(t *testing.T) {
+
+	for name, want := range map[string]bool{
+		"pack/game.ch8":   true,
+		"pack/GAME.XO8":   true,
+		"pack/game":       true,
+		"pack/README":     false,
+		"pack/LICENSE":    false,
+		"pack/readme.md":  false,
+		"pack/game.txt":   false,
+		"pack/Readme.1st": false,
+	} {
+		if got := IsRom(name); got != want {
+			t.Errorf("IsRom(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestZipPack(t *testing.T) {
+
+	name := filepath.Join(t.TempDir(), "pack.zip")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	for file, data := range map[string]string{
+		"pack/README":      "about the pack",
+		"pack/LICENSE":     "do as you please",
+		"pack/game.ch8":    "\x12\x00",
+		"pack/game.txt":    "about the game",
+		"two/README":       "about two",
+		"two/a.ch8":        "\x12\x00",
+		"two/b.ch8":        "\x12\x00",
+		"one/only.ch8":     "\x12\x00",
+		"one/notes.txt":    "notes",
+		"one/README.md":    "about one",
+		"one/LICENSE.txt":  "do as you please",
+		"solo/solo":        "\x12\x00",
+		"solo/readme.txt":  "about solo",
+		"solo/credits.txt": "thanks",
+	} {
+		w, err := zw.Create(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(data))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	src, err := Zip(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roms, err := src.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"pack/game.ch8": "about the game",
+		"two/a.ch8":     "",
+		"two/b.ch8":     "",
+		"one/only.ch8":  "about one",
+		"solo/solo":     "about solo",
+	}
+	if len(roms) != len(want) {
+		t.Errorf("listed %d ROMs, want %d: %v", len(roms), len(want), roms)
+	}
+	for _, r := range roms {
+		if desc, ok := want[r.Name]; !ok {
+			t.Errorf("%s listed as a ROM", r.Name)
+		} else if r.Description != desc {
+			t.Errorf("%s described as %q, want %q", r.Name, r.Description, desc)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package library
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+//RecentMax is how many of the ROMs played last count as recently played
+const RecentMax = 8
+
+type (
+	//Stats remember the favorite ROMs of the player, what they played
+	//and the cycles per frame they set, by ROM hash.
+	//They are kept in a JSON file, saved after every change.
+	Stats struct {
+		path      string
+		Favorites map[string]bool   `json:"favorites"`
+		Played    map[string]*Plays `json:"played"`
+		TickRates map[string]int    `json:"tickrates"`
+		//started is when the session going on began
+		started time.Time
+	}
+
+	//Plays sums up the sessions of a ROM
+	Plays struct {
+		//Name is the file name the ROM was last played from
+		Name  string        `json:"name"`
+		Count int           `json:"count"`
+		Time  time.Duration `json:"time"`
+		Last  time.Time     `json:"last"`
+	}
+)
+
+//LoadStats reads the stats kept in the file at path, which need not exist yet.
+//With an empty path the stats are only kept in memory.
+func LoadStats(path string) (*Stats, error) {
+
+	s := &Stats{
+		path:      path,
+		Favorites: make(map[string]bool),
+		Played:    make(map[string]*Plays),
+		TickRates: make(map[string]int),
+	}
+
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return s, err
+	}
+	if s.Favorites == nil {
+		s.Favorites = make(map[string]bool)
+	}
+	if s.Played == nil {
+		s.Played = make(map[string]*Plays)
+	}
+	if s.TickRates == nil {
+		s.TickRates = make(map[string]int)
+	}
+	return s, nil
+}
+
+//Save writes the stats to their file, replacing it whole so a crash never leaves half of it
+func (s *Stats) Save() error {
+
+	if s.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(s, "", "\t")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+//Favorite tells whether the ROM with the given hash is a favorite
+func (s *Stats) Favorite(hash string) bool {
+	return s.Favorites[hash]
+}
+
+//ToggleFavorite makes the ROM with the given hash a favorite, or no longer one
+func (s *Stats) ToggleFavorite(hash string) error {
+
+	if s.Favorites[hash] {
+		delete(s.Favorites, hash)
+	} else {
+		s.Favorites[hash] = true
+	}
+	return s.Save()
+}
+
+//TickRate is the cycles per frame set for the ROM with the given hash, 0 if none was
+func (s *Stats) TickRate(hash string) int {
+	return s.TickRates[hash]
+}
+
+//SetTickRate remembers the cycles per frame set for the ROM with the given hash
+func (s *Stats) SetTickRate(hash string, n int) error {
+	s.TickRates[hash] = n
+	return s.Save()
+}
+
+//Plays returns what was played of the ROM with the given hash, nil if it never was
+func (s *Stats) Plays(hash string) *Plays {
+	return s.Played[hash]
+}
+
+//Recent lists the hashes of the RecentMax ROMs played last, the last one first
+func (s *Stats) Recent() []string {
+
+	hashes := make([]string, 0, len(s.Played))
+	for h := range s.Played {
+		hashes = append(hashes, h)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return s.Played[hashes[i]].Last.After(s.Played[hashes[j]].Last)
+	})
+	if len(hashes) > RecentMax {
+		hashes = hashes[:RecentMax]
+	}
+	return hashes
+}
+
+//Begin records the start of a session playing the ROM with the given hash, read from the named file
+func (s *Stats) Begin(hash, name string) error {
+
+	p, ok := s.Played[hash]
+	if !ok {
+		p = &Plays{}
+		s.Played[hash] = p
+	}
+	s.started = time.Now()
+	p.Name = filepath.Base(name)
+	p.Count++
+	p.Last = s.started
+	return s.Save()
+}
+
+//End records the end of the session Begin started, adding its length to the play time of the ROM
+func (s *Stats) End(hash string) error {
+
+	p, ok := s.Played[hash]
+	if !ok || s.started.IsZero() {
+		return nil
+	}
+	p.Time += time.Since(s.started)
+	s.started = time.Time{}
+	return s.Save()
+}
@@ -0,0 +1,91 @@
+package library
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+//memSource holds the files of an archive in memory, by path
+type memSource struct {
+	label string
+	files map[string][]byte
+}
+
+//Tar is the source of the ROMs in a tar archive, gzipped if its name ends in .gz or .tgz.
+//The whole archive is read into memory.
+func Tar(name string) (RomSource, error) {
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if ext := strings.ToLower(filepath.Ext(name)); ext == ".gz" || ext == ".tgz" {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	s := &memSource{label: filepath.Base(name), files: make(map[string][]byte)}
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		s.files[path.Clean(strings.TrimPrefix(h.Name, "/"))] = data
+	}
+	return s, nil
+}
+
+func (s *memSource) Label() string {
+	return s.label
+}
+
+func (s *memSource) List() ([]Rom, error) {
+
+	var roms []Rom
+	texts := make(map[string]string)
+	for name, data := range s.files {
+		switch {
+		case hidden(name):
+		case isText(name) && len(data) <= maxDescription:
+			texts[name] = string(data)
+		case IsRom(name):
+			roms = append(roms, Rom{Name: name, Size: int64(len(data)), Source: s})
+		}
+	}
+	describe(roms, texts)
+	sortRoms(roms)
+	return roms, nil
+}
+
+func (s *memSource) Open(name string) ([]byte, error) {
+
+	data, ok := s.files[path.Clean(filepath.ToSlash(name))]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return data, nil
+}
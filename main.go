@@ -2,47 +2,238 @@ package main
 
 import (
 	"bytes"
+	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/makoto126/term-atari/gui"
+	"github.com/makoto126/term-atari/library"
+	"github.com/makoto126/term-atari/romdb"
 	"github.com/makoto126/term-atari/vm"
 )
 
+var (
+	quirks = flag.String("quirks", "", "quirks profile: vip, chip48, schip1, schip or xochip (default: from the ROM database)")
+	dbPath = flag.String("db", "", "extra chip-8-database programs.json to look ROMs up in")
+	rewind = flag.Int("rewind", 16, "memory budget of the rewind buffer in MiB, 0 disables rewinding")
+	debug  = flag.Bool("debug", false, "show the debug panel and pause at the first instruction")
+	breaks = flag.String("break", "", "comma separated hex PC addresses to break at")
+	dbg    = flag.String("dbg", "", "semicolon separated debugger commands to start with, such as \"w 300; b 204 if V3 == 0x10\"")
+	render = flag.String("render", "full", "how to draw the display: full, half to fit 128x64 SCHIP graphics in 32 rows, braille for small terminals, or the sixel and kitty bitmaps")
+	scale  = flag.Int("scale", 4, "size in screen pixels of a CHIP-8 pixel with the sixel and kitty renderers")
+	ticks  = flag.Int("tickrate", 0, "cycles per 60Hz frame (default: the one last set for the ROM with [ and ], else from the ROM database)")
+)
+
+const (
+	//rewindSeconds is how far back the rewind buffer reaches
+	rewindSeconds = 30
+	//rewindStep is how many frames each rewind key repeat goes back
+	rewindStep = 4
+)
+
+var (
+	//db is the ROM database, with the -db file merged in
+	db = romdb.Default()
+	//cmds are the debugger commands from -break and -dbg
+	cmds []string
+	//stats are the favorites and play times of the player
+	stats *library.Stats
+)
+
 func main() {
 
-	for {
-		rom := gui.SelectRom(AssetNames())
-		if rom == "" {
-			break
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `usage:
+  term-atari [flags] [ROM, DIR or ARCHIVE]...  pick a game from the built-in ROMs, the ones given and a file browser
+  term-atari [flags] run FILE                  play a ROM, assembly (.asm) or Octo (.8o) source
+  term-atari [flags] headless [options] FILE   run a ROM or source without a terminal, see headless -h
+  term-atari disasm ROM...                     print the listing of ROMs
+
+flags:`)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	switch flag.Arg(0) {
+	case "disasm":
+		if err := disasmCmd(flag.Args()[1:]); err != nil {
+			log.Fatalln(err)
 		}
+		return
+	}
 
-		term := new(gui.Term)
+	if _, ok := vm.Presets[*quirks]; *quirks != "" && !ok {
+		log.Fatalf("unknown quirks profile %q", *quirks)
+	}
+	if _, ok := gui.Renderers[*render]; !ok {
+		log.Fatalf("unknown renderer %q", *render)
+	}
+	if *scale < 1 {
+		log.Fatalf("bad scale %d", *scale)
+	}
 
-		quit, err := term.Init()
+	for _, a := range strings.Split(*breaks, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			cmds = append(cmds, "b "+a)
+		}
+	}
+	for _, cmd := range strings.Split(*dbg, ";") {
+		if cmd = strings.TrimSpace(cmd); cmd != "" {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	if *dbPath != "" {
+		f, err := os.Open(*dbPath)
 		if err != nil {
 			log.Fatalln(err)
 		}
+		err = db.Load(f)
+		f.Close()
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
 
-		chip8 := new(vm.Chip8)
+	var err error
+	if stats, err = loadStats(); err != nil {
+		log.Println(err)
+	}
 
-		chip8.Init(
-			term,
-			term,
-			term,
-			quit,
-		)
+	switch flag.Arg(0) {
+	case "run":
+		if err := runCmd(flag.Args()[1:]); err != nil {
+			log.Fatalln(err)
+		}
+		return
+	case "headless":
+		if err := headlessCmd(flag.Args()[1:]); err != nil {
+			log.Fatalln(err)
+		}
+		return
+	}
 
-		data, err := Asset(rom)
+	lib, err := openLibrary(flag.Args())
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	for {
+		rom, ok := gui.SelectRom(lib, db, stats)
+		if !ok {
+			break
+		}
+
+		p, err := openProgram(rom)
 		if err != nil {
 			log.Fatalln(err)
 		}
 
-		if err := chip8.Load(bytes.NewBuffer(data)); err != nil {
+		if err := play(p); err != nil {
 			log.Fatalln(err)
 		}
+	}
+}
 
-		if err := chip8.Loop(); err != nil {
-			log.Fatalln(err)
+//lookup finds a ROM in the database, returning the quirks to run it with:
+//those of -quirks, else those of its entry, else none, as the assembler and disassembler assume
+func lookup(rom []byte) (romdb.Entry, vm.Quirks) {
+
+	entry, found := db.Lookup(rom)
+	var q vm.Quirks
+	if found {
+		q = entry.Quirks
+	}
+	if *quirks != "" {
+		q = vm.Presets[*quirks]
+	}
+	return entry, q
+}
+
+//tickRate is the cycles per frame to run a ROM at: -tickrate, else the ones set for it,
+//else those of its database entry, 0 for the default
+func tickRate(entry, set int) int {
+
+	switch {
+	case *ticks > 0:
+		return *ticks
+	case set > 0:
+		return set
+	default:
+		return entry
+	}
+}
+
+//play runs a program until the player quits
+func play(p program) error {
+
+	entry, q := lookup(p.rom)
+
+	term := new(gui.Term)
+	term.Bind(entry.Keys)
+	term.Render(gui.Renderers[*render](*scale))
+
+	quit, err := term.Init()
+	if err != nil {
+		return err
+	}
+	//the game may end without the player quitting: by EXIT, a fault or an error setting it up
+	defer term.Close()
+
+	chip8 := new(vm.Chip8)
+
+	chip8.Init(
+		term,
+		term,
+		term,
+		quit,
+		q,
+	)
+
+	if n := tickRate(entry.TickRate, stats.TickRate(p.id)); n > 0 {
+		chip8.SetTickRate(n)
+	}
+	term.OnTickRate(func(n int) {
+		if err := stats.SetTickRate(p.id, n); err != nil {
+			term.Status("Stats: " + err.Error())
+		}
+	})
+
+	bindSlots(term, chip8, romdb.Hash(p.rom))
+
+	term.Debug(chip8, *debug)
+	chip8.SetSource(p.source)
+	for _, a := range p.breakpoints {
+		chip8.SetBreakpoint(a, true)
+	}
+	for _, cmd := range cmds {
+		if err := chip8.Command(cmd); err != nil {
+			return fmt.Errorf("%s: %v", cmd, err)
 		}
 	}
+	if *debug {
+		chip8.Pause()
+	}
+
+	if *rewind > 0 {
+		chip8.EnableRewind(rewindSeconds, *rewind<<20)
+		term.OnRewind(func() {
+			chip8.StepBack(rewindStep)
+		})
+	}
+
+	if err := chip8.Load(bytes.NewBuffer(p.rom)); err != nil {
+		return err
+	}
+
+	if err := stats.Begin(p.id, p.name); err != nil {
+		term.Status("Stats: " + err.Error())
+	}
+	err = chip8.Loop()
+	if serr := stats.End(p.id); serr != nil {
+		log.Println(serr)
+	}
+	return err
 }
@@ -0,0 +1,115 @@
+package octo
+
+import "math"
+
+//calcUnary are the prefix operators of :calc expressions
+var calcUnary = map[string]func(float64) float64{
+	"-":     func(x float64) float64 { return -x },
+	"~":     func(x float64) float64 { return float64(^int(x)) },
+	"!":     func(x float64) float64 { return bool2float(x == 0) },
+	"sin":   math.Sin,
+	"cos":   math.Cos,
+	"tan":   math.Tan,
+	"exp":   math.Exp,
+	"log":   math.Log,
+	"abs":   math.Abs,
+	"sqrt":  math.Sqrt,
+	"sign":  sign,
+	"ceil":  math.Ceil,
+	"floor": math.Floor,
+}
+
+//calcBinary are the infix operators of :calc expressions
+var calcBinary = map[string]func(float64, float64) float64{
+	"-":   func(x, y float64) float64 { return x - y },
+	"+":   func(x, y float64) float64 { return x + y },
+	"*":   func(x, y float64) float64 { return x * y },
+	"/":   func(x, y float64) float64 { return x / y },
+	"%":   math.Mod,
+	"&":   func(x, y float64) float64 { return float64(int(x) & int(y)) },
+	"|":   func(x, y float64) float64 { return float64(int(x) | int(y)) },
+	"^":   func(x, y float64) float64 { return float64(int(x) ^ int(y)) },
+	"<<":  func(x, y float64) float64 { return float64(int(x) << uint(int(y)&63)) },
+	">>":  func(x, y float64) float64 { return float64(int(x) >> uint(int(y)&63)) },
+	"pow": math.Pow,
+	"min": math.Min,
+	"max": math.Max,
+	"<":   func(x, y float64) float64 { return bool2float(x < y) },
+	"<=":  func(x, y float64) float64 { return bool2float(x <= y) },
+	"==":  func(x, y float64) float64 { return bool2float(x == y) },
+	"!=":  func(x, y float64) float64 { return bool2float(x != y) },
+	">=":  func(x, y float64) float64 { return bool2float(x >= y) },
+	">":   func(x, y float64) float64 { return bool2float(x > y) },
+}
+
+//calc evaluates the expression of a :calc after its opening brace, through the closing one.
+//As in Octo, operators have no precedence and group from the right, so 2 * 3 + 1 is 8.
+//Besides numbers, constants and defined labels, expressions may use
+//HERE for the current address, PI, E and @ addr for the byte compiled at addr.
+func (c *compiler) calc() float64 {
+
+	v := c.calcExpr()
+	c.expect("}")
+	return v
+}
+
+func (c *compiler) calcExpr() float64 {
+
+	x := c.calcTerm()
+	if f, ok := calcBinary[c.peek().text]; ok {
+		c.take()
+		return f(x, c.calcExpr())
+	}
+	return x
+}
+
+func (c *compiler) calcTerm() float64 {
+
+	t := c.take()
+	switch t.text {
+	case "(":
+		v := c.calcExpr()
+		c.expect(")")
+		return v
+	case "@":
+		a := int(c.calcTerm())
+		if a < Base || a-Base >= len(c.rom) {
+			return 0
+		}
+		return float64(c.rom[a-Base])
+	case "HERE":
+		return float64(c.here)
+	case "PI":
+		return math.Pi
+	case "E":
+		return math.E
+	}
+	if f, ok := calcUnary[t.text]; ok {
+		return f(c.calcTerm())
+	}
+	if v, ok := c.consts[t.text]; ok {
+		return v
+	}
+	v, ok := c.value(t)
+	if !ok {
+		c.fail(t.pos, "undefined %q", t.text)
+	}
+	return float64(v)
+}
+
+func bool2float(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func sign(x float64) float64 {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
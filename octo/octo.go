@@ -0,0 +1,837 @@
+//Package octo compiles programs written in Octo, the high level CHIP-8
+//assembly language of the Octo IDE, into ROMs vm.Chip8 runs.
+//
+//It understands the statements of the Octo manual (v0 := 5, i := label,
+//sprite v0 v1 5, if v0 == 1 then ..., a label name alone to call it, a number alone
+//for a byte of data) and its directives:
+//	: name                    label the next address
+//	:alias name v3            another name for a register
+//	:const name 12            a constant
+//	:macro name a b { ... }   a macro, expanded with its arguments substituted
+//	:calc name { 2 * x }      a constant computed from numbers, constants and defined labels
+//	:byte 7, :pointer label   a byte or a big endian address of data
+//	:org 0x400                continue at another address
+//	:next name                label the immediate byte of the next instruction
+//	:unpack 0xA label         v0 := 0xA << 4 | high bits of label, v1 := low bits of label
+//	:call addr                call an address
+//	:breakpoint name          stop the debugger at the next instruction
+//	:assert "msg" { expr }    fail unless expr is true
+//	loop ... while cond ... again
+//	if cond then statement, if cond begin ... else ... end
+//together with the SCHIP and XO-CHIP statements hires, lores, exit, scroll-*,
+//saveflags, loadflags, plane, audio, pitch := vx, save vx - vy, load vx - vy and i := long addr.
+//:monitor and :proto are accepted and ignored.
+package octo
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/makoto126/term-atari/asm"
+	"github.com/makoto126/term-atari/vm"
+)
+
+//Base is the address programs are compiled for
+const Base = 0x200
+
+//maxExpand bounds macro expansions, catching macros that expand themselves
+const maxExpand = 1 << 16
+
+//Program is a compiled ROM
+type Program struct {
+	ROM []byte
+	//Source gives the source line of each instruction
+	Source vm.SourceMap
+	//Breakpoints are the addresses of the :breakpoint directives, by name
+	Breakpoints map[uint16]string
+}
+
+type (
+	token struct {
+		text string
+		pos  asm.Pos
+	}
+
+	macro struct {
+		args []string
+		body []token
+	}
+
+	//fixup waits for a label used before it is defined
+	fixup struct {
+		tok   token
+		patch func(addr int)
+	}
+
+	//branch is an open if ... begin or else, at being its jump to patch
+	branch struct {
+		at  int
+		pos asm.Pos
+	}
+
+	//loop is an open loop, whiles being its jumps out to patch
+	loop struct {
+		at     int
+		whiles []int
+		pos    asm.Pos
+	}
+
+	compiler struct {
+		toks     []token
+		next     int
+		lines    []string
+		rom      []byte
+		here     int
+		labels   map[string]int
+		consts   map[string]float64
+		aliases  map[string]int
+		macros   map[string]macro
+		expanded int
+		fixups   []fixup
+		branches []branch
+		loops    []loop
+		stmt     token
+		prog     *Program
+	}
+)
+
+//negations are the comparisons with the opposite outcome
+var negations = map[string]string{
+	"==": "!=", "!=": "==",
+	"key": "-key", "-key": "key",
+	"<": ">=", ">=": "<",
+	">": "<=", "<=": ">",
+}
+
+//Compile compiles the Octo source of the named file
+func Compile(name string, src []byte) (prog *Program, err error) {
+
+	text := strings.Replace(string(src), "\r\n", "\n", -1)
+	c := &compiler{
+		toks:   tokenize(name, text),
+		lines:  strings.Split(text, "\n"),
+		here:   Base,
+		labels: make(map[string]int),
+		consts: make(map[string]float64),
+		//the registers pseudo instructions use, which Octo lets programs rename
+		aliases: map[string]int{"compare-temp": 0xF, "unpack-hi": 0x0, "unpack-lo": 0x1},
+		macros:  make(map[string]macro),
+		prog: &Program{
+			Source:      make(vm.SourceMap),
+			Breakpoints: make(map[uint16]string),
+		},
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*asm.Error)
+			if !ok {
+				panic(r)
+			}
+			prog, err = nil, e
+		}
+	}()
+
+	for c.next < len(c.toks) {
+		c.stmt = c.take()
+		c.statement(c.stmt)
+	}
+	for _, f := range c.fixups {
+		a, ok := c.labels[f.tok.text]
+		if !ok {
+			c.fail(f.tok.pos, "undefined label %q", f.tok.text)
+		}
+		f.patch(a)
+	}
+	if len(c.branches) > 0 {
+		c.fail(c.branches[len(c.branches)-1].pos, "begin without end")
+	}
+	if len(c.loops) > 0 {
+		c.fail(c.loops[len(c.loops)-1].pos, "loop without again")
+	}
+
+	c.prog.ROM = c.rom
+	return c.prog, nil
+}
+
+//CompileFile compiles an Octo source file from disk
+func CompileFile(path string) (*Program, error) {
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Compile(path, src)
+}
+
+//tokenize splits a source into words, dropping # comments.
+//Braces and parentheses are words of their own, and "quoted strings" are single words.
+func tokenize(name, src string) []token {
+
+	var toks []token
+	for n, line := range strings.Split(src, "\n") {
+		for i := 0; i < len(line); {
+			pos := asm.Pos{File: name, Line: n + 1, Col: i + 1}
+			switch ch := line[i]; {
+			case ch == '#':
+				i = len(line)
+			case ch == ' ' || ch == '\t' || ch == '\r':
+				i++
+			case strings.IndexByte("{}()", ch) >= 0:
+				toks = append(toks, token{line[i : i+1], pos})
+				i++
+			case ch == '"':
+				j := strings.IndexByte(line[i+1:], '"')
+				if j < 0 {
+					j = len(line) - i - 1
+				} else {
+					j++
+				}
+				toks = append(toks, token{line[i : i+j+1], pos})
+				i += j + 1
+			default:
+				j := i
+				for j < len(line) && strings.IndexByte(" \t\r{}()#", line[j]) < 0 {
+					j++
+				}
+				toks = append(toks, token{line[i:j], pos})
+				i = j
+			}
+		}
+	}
+	return toks
+}
+
+func (c *compiler) fail(pos asm.Pos, format string, args ...interface{}) {
+	panic(&asm.Error{Pos: pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (c *compiler) peek() token {
+	if c.next < len(c.toks) {
+		return c.toks[c.next]
+	}
+	return token{}
+}
+
+//take consumes the next token
+func (c *compiler) take() token {
+
+	if c.next == len(c.toks) {
+		pos := c.stmt.pos
+		if len(c.toks) > 0 {
+			pos = c.toks[len(c.toks)-1].pos
+		}
+		c.fail(pos, "unexpected end of file")
+	}
+	c.next++
+	return c.toks[c.next-1]
+}
+
+func (c *compiler) expect(text string) token {
+
+	t := c.take()
+	if t.text != text {
+		c.fail(t.pos, "expected %q, got %q", text, t.text)
+	}
+	return t
+}
+
+//statement compiles the statement starting with t
+func (c *compiler) statement(t token) {
+
+	switch t.text {
+	case ":":
+		c.label(c.take(), c.here)
+	case ":alias":
+		name := c.take()
+		var r int
+		if c.peek().text == "{" {
+			r = c.ranged(c.peek(), c.number(), 0, 15)
+		} else {
+			r = c.reg()
+		}
+		c.checkName(name)
+		c.aliases[name.text] = r
+	case ":const":
+		name := c.take()
+		v := c.number()
+		c.define(name, float64(v))
+	case ":calc":
+		name := c.take()
+		c.expect("{")
+		c.define(name, c.calc())
+	case ":macro":
+		c.defineMacro()
+	case ":byte":
+		c.emit(byte(c.ranged(c.peek(), c.number(), -0x80, 0xFF)))
+	case ":pointer":
+		at := c.here
+		c.emit(0, 0)
+		c.resolve(c.take(), func(a int) {
+			c.rom[at-Base], c.rom[at-Base+1] = byte(a>>8), byte(a)
+		})
+	case ":org":
+		at := c.peek()
+		c.here = c.ranged(at, c.number(), Base, 0xFFFF)
+	case ":next":
+		c.label(c.take(), c.here+1)
+	case ":unpack":
+		c.unpack()
+	case ":call":
+		c.addrInst(0x2, c.take())
+	case ":breakpoint":
+		c.prog.Breakpoints[uint16(c.here)] = c.take().text
+	case ":assert":
+		msg := "assertion failed"
+		if strings.HasPrefix(c.peek().text, `"`) {
+			msg = strings.Trim(c.take().text, `"`)
+		}
+		c.expect("{")
+		if c.calc() == 0 {
+			c.fail(t.pos, "%s", msg)
+		}
+	case ":monitor":
+		c.take()
+		c.take()
+	case ":proto":
+		c.take()
+	case ";", "return":
+		c.inst(0x00, 0xEE)
+	case "clear":
+		c.inst(0x00, 0xE0)
+	case "exit":
+		c.inst(0x00, 0xFD)
+	case "lores":
+		c.inst(0x00, 0xFE)
+	case "hires":
+		c.inst(0x00, 0xFF)
+	case "scroll-right":
+		c.inst(0x00, 0xFB)
+	case "scroll-left":
+		c.inst(0x00, 0xFC)
+	case "scroll-down":
+		c.inst(0x00, 0xC0|c.nibble())
+	case "scroll-up":
+		c.inst(0x00, 0xD0|c.nibble())
+	case "audio":
+		c.inst(0xF0, 0x02)
+	case "plane":
+		c.inst(0xF0|c.nibble(), 0x01)
+	case "bcd":
+		c.inst(0xF0|byte(c.reg()), 0x33)
+	case "saveflags":
+		c.inst(0xF0|byte(c.reg()), 0x75)
+	case "loadflags":
+		c.inst(0xF0|byte(c.reg()), 0x85)
+	case "save", "load":
+		x := c.reg()
+		if c.peek().text == "-" {
+			c.take()
+			y := c.reg()
+			c.inst(0x50|byte(x), byte(y<<4)|map[string]byte{"save": 2, "load": 3}[t.text])
+			break
+		}
+		c.inst(0xF0|byte(x), map[string]byte{"save": 0x55, "load": 0x65}[t.text])
+	case "sprite":
+		x := c.reg()
+		y := c.reg()
+		c.inst(0xD0|byte(x), byte(y<<4)|c.nibble())
+	case "jump":
+		c.addrInst(0x1, c.take())
+	case "jump0":
+		c.addrInst(0xB, c.take())
+	case "native":
+		c.addrInst(0x0, c.take())
+	case "delay", "buzzer", "pitch":
+		c.expect(":=")
+		c.inst(0xF0|byte(c.reg()), map[string]byte{"delay": 0x15, "buzzer": 0x18, "pitch": 0x3A}[t.text])
+	case "i":
+		c.index()
+	case "if":
+		c.ifStatement()
+	case "else":
+		if len(c.branches) == 0 {
+			c.fail(t.pos, "else without begin")
+		}
+		b := c.branches[len(c.branches)-1]
+		c.branches[len(c.branches)-1] = branch{c.here, t.pos}
+		c.inst(0x10, 0x00)
+		c.patchJump(b.at, c.here)
+	case "end":
+		if len(c.branches) == 0 {
+			c.fail(t.pos, "end without begin")
+		}
+		b := c.branches[len(c.branches)-1]
+		c.branches = c.branches[:len(c.branches)-1]
+		c.patchJump(b.at, c.here)
+	case "loop":
+		c.loops = append(c.loops, loop{at: c.here, pos: t.pos})
+	case "while":
+		if len(c.loops) == 0 {
+			c.fail(t.pos, "while outside a loop")
+		}
+		c.conditional(true)
+		l := &c.loops[len(c.loops)-1]
+		l.whiles = append(l.whiles, c.here)
+		c.inst(0x10, 0x00)
+	case "again":
+		if len(c.loops) == 0 {
+			c.fail(t.pos, "again without loop")
+		}
+		l := c.loops[len(c.loops)-1]
+		c.loops = c.loops[:len(c.loops)-1]
+		at := c.here
+		c.inst(0x10, 0x00)
+		c.patchJump(at, l.at)
+		for _, w := range l.whiles {
+			c.patchJump(w, c.here)
+		}
+	default:
+		if x, ok := c.register(t); ok {
+			c.assign(x)
+			return
+		}
+		if m, ok := c.macros[t.text]; ok {
+			c.expand(t, m)
+			return
+		}
+		if v, ok := c.value(t); ok && !c.isLabel(t) {
+			c.emit(byte(c.ranged(t, v, -0x80, 0xFF)))
+			return
+		}
+		if isName(t.text) {
+			c.addrInst(0x2, t)
+			return
+		}
+		c.fail(t.pos, "unexpected %q", t.text)
+	}
+}
+
+//assign compiles the statements on a register, vx := 5, vx += vy and so on
+func (c *compiler) assign(x int) {
+
+	op := c.take()
+	hi := byte(x)
+	if op.text == ":=" {
+		switch c.peek().text {
+		case "random":
+			c.take()
+			c.inst(0xC0|hi, c.short())
+			return
+		case "key":
+			c.take()
+			c.inst(0xF0|hi, 0x0A)
+			return
+		case "delay":
+			c.take()
+			c.inst(0xF0|hi, 0x07)
+			return
+		}
+	}
+
+	if y, ok := c.register(c.peek()); ok {
+		c.take()
+		alu := map[string]byte{":=": 0x0, "|=": 0x1, "&=": 0x2, "^=": 0x3, "+=": 0x4, "-=": 0x5, ">>=": 0x6, "=-": 0x7, "<<=": 0xE}
+		n, ok := alu[op.text]
+		if !ok {
+			c.fail(op.pos, "unexpected %q", op.text)
+		}
+		c.inst(0x80|hi, byte(y<<4)|n)
+		return
+	}
+
+	switch op.text {
+	case ":=":
+		c.inst(0x60|hi, c.short())
+	case "+=":
+		c.inst(0x70|hi, c.short())
+	case "-=":
+		c.inst(0x70|hi, -c.short())
+	default:
+		c.fail(op.pos, "%s needs a register", op.text)
+	}
+}
+
+//index compiles the statements on i
+func (c *compiler) index() {
+
+	op := c.take()
+	switch op.text {
+	case ":=":
+		switch c.peek().text {
+		case "long":
+			c.take()
+			c.inst(0xF0, 0x00)
+			at := c.here
+			c.emit(0, 0)
+			c.resolve(c.take(), func(a int) {
+				c.rom[at-Base], c.rom[at-Base+1] = byte(a>>8), byte(a)
+			})
+		case "hex":
+			c.take()
+			c.inst(0xF0|byte(c.reg()), 0x29)
+		case "bighex":
+			c.take()
+			c.inst(0xF0|byte(c.reg()), 0x30)
+		default:
+			c.addrInst(0xA, c.take())
+		}
+	case "+=":
+		c.inst(0xF0|byte(c.reg()), 0x1E)
+	default:
+		c.fail(op.pos, "unexpected %q", op.text)
+	}
+}
+
+//ifStatement compiles if cond then statement and if cond begin
+func (c *compiler) ifStatement() {
+
+	//the comparison is compiled differently for then and begin, so look for them first
+	i := c.next
+	for i < len(c.toks) && c.toks[i].text != "then" && c.toks[i].text != "begin" {
+		i++
+	}
+	if i == len(c.toks) {
+		c.fail(c.stmt.pos, "if without then or begin")
+	}
+
+	if c.toks[i].text == "then" {
+		c.conditional(false)
+		c.expect("then")
+		return
+	}
+	c.conditional(true)
+	c.expect("begin")
+	c.branches = append(c.branches, branch{c.here, c.stmt.pos})
+	c.inst(0x10, 0x00)
+}
+
+//conditional compiles a comparison into instructions that skip the next one when
+//it is false, or when it is true if negate is set
+func (c *compiler) conditional(negate bool) {
+
+	x := byte(c.reg())
+	t := c.take()
+	op := t.text
+	if negate {
+		op = negations[op]
+	}
+	temp := byte(c.aliases["compare-temp"])
+
+	switch op {
+	case "==", "!=":
+		if y, ok := c.register(c.peek()); ok {
+			c.take()
+			c.inst(map[string]byte{"==": 0x90, "!=": 0x50}[op]|x, byte(y<<4))
+		} else {
+			c.inst(map[string]byte{"==": 0x40, "!=": 0x30}[op]|x, c.short())
+		}
+	case "key":
+		c.inst(0xE0|x, 0xA1)
+	case "-key":
+		c.inst(0xE0|x, 0x9E)
+	case "<", ">", "<=", ">=":
+		if y, ok := c.register(c.peek()); ok {
+			c.take()
+			c.inst(0x80|temp, byte(y<<4))
+		} else {
+			c.inst(0x60|temp, c.short())
+		}
+		if op == ">" || op == "<=" {
+			c.inst(0x80|temp, x<<4|0x5)
+		} else {
+			c.inst(0x80|temp, x<<4|0x7)
+		}
+		if op == "<" || op == ">" {
+			c.inst(0x3F, 0x01)
+		} else {
+			c.inst(0x4F, 0x01)
+		}
+	default:
+		c.fail(t.pos, "expected a comparison, got %q", t.text)
+	}
+}
+
+//unpack compiles :unpack nibble label and :unpack long label
+func (c *compiler) unpack() {
+
+	var hi int
+	long := c.peek().text == "long"
+	if long {
+		c.take()
+	} else {
+		hi = c.ranged(c.peek(), c.number(), 0, 15) << 4
+	}
+	at := c.here
+	c.inst(0x60|byte(c.aliases["unpack-hi"]), byte(hi))
+	c.inst(0x60|byte(c.aliases["unpack-lo"]), 0)
+
+	t := c.take()
+	c.resolve(t, func(a int) {
+		if !long && a > 0xFFF {
+			c.fail(t.pos, "address 0x%X needs :unpack long", a)
+		}
+		c.rom[at-Base+1] |= byte(a >> 8)
+		c.rom[at-Base+3] = byte(a)
+	})
+}
+
+//defineMacro compiles :macro name args { body }
+func (c *compiler) defineMacro() {
+
+	name := c.take()
+	var m macro
+	for {
+		t := c.take()
+		if t.text == "{" {
+			break
+		}
+		m.args = append(m.args, t.text)
+	}
+	for depth := 1; ; {
+		t := c.take()
+		switch t.text {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		}
+		if depth == 0 {
+			break
+		}
+		m.body = append(m.body, t)
+	}
+	c.checkName(name)
+	c.macros[name.text] = m
+}
+
+//expand replaces a macro call with the body of the macro
+func (c *compiler) expand(t token, m macro) {
+
+	c.expanded++
+	if c.expanded > maxExpand {
+		c.fail(t.pos, "macro %s expands forever", t.text)
+	}
+
+	args := make(map[string]token, len(m.args))
+	for _, a := range m.args {
+		args[a] = c.take()
+	}
+	toks := make([]token, 0, len(m.body)+len(c.toks)-c.next)
+	for _, b := range m.body {
+		if a, ok := args[b.text]; ok {
+			b.text = a.text
+		}
+		toks = append(toks, b)
+	}
+	c.toks = append(toks, c.toks[c.next:]...)
+	c.next = 0
+}
+
+//label defines a label
+func (c *compiler) label(name token, addr int) {
+
+	c.checkName(name)
+	if _, ok := c.labels[name.text]; ok {
+		c.fail(name.pos, "%q redefined", name.text)
+	}
+	if _, ok := c.consts[name.text]; ok {
+		c.fail(name.pos, "%q redefined", name.text)
+	}
+	c.labels[name.text] = addr
+}
+
+//define defines a constant
+func (c *compiler) define(name token, v float64) {
+
+	c.checkName(name)
+	if _, ok := c.labels[name.text]; ok {
+		c.fail(name.pos, "%q redefined", name.text)
+	}
+	c.consts[name.text] = v
+}
+
+func (c *compiler) checkName(name token) {
+
+	if !isName(name.text) {
+		c.fail(name.pos, "bad name %q", name.text)
+	}
+	if _, ok := register(name.text); ok {
+		c.fail(name.pos, "%q is a register", name.text)
+	}
+}
+
+//register parses an alias or v0-vf
+func (c *compiler) register(t token) (int, bool) {
+
+	if r, ok := c.aliases[t.text]; ok {
+		return r, true
+	}
+	return register(t.text)
+}
+
+//reg takes a register
+func (c *compiler) reg() int {
+
+	t := c.take()
+	r, ok := c.register(t)
+	if !ok {
+		c.fail(t.pos, "expected a register, got %q", t.text)
+	}
+	return r
+}
+
+func (c *compiler) isLabel(t token) bool {
+	_, ok := c.labels[t.text]
+	return ok
+}
+
+//value is the number a token stands for, if it is known yet
+func (c *compiler) value(t token) (int, bool) {
+
+	if isNumber(t.text) {
+		n, err := strconv.ParseInt(t.text, 0, 32)
+		if err != nil {
+			c.fail(t.pos, "bad number %q", t.text)
+		}
+		return int(n), true
+	}
+	if v, ok := c.consts[t.text]; ok {
+		return int(v), true
+	}
+	if a, ok := c.labels[t.text]; ok {
+		return a, true
+	}
+	return 0, false
+}
+
+//number takes a value or a { calc } expression, which must be known
+func (c *compiler) number() int {
+
+	t := c.take()
+	if t.text == "{" {
+		return int(c.calc())
+	}
+	v, ok := c.value(t)
+	if !ok {
+		c.fail(t.pos, "undefined %q", t.text)
+	}
+	return v
+}
+
+func (c *compiler) ranged(t token, v, lo, hi int) int {
+
+	if v < lo || v > hi {
+		c.fail(t.pos, "%d is outside %d-%d", v, lo, hi)
+	}
+	return v
+}
+
+//short takes a byte operand
+func (c *compiler) short() byte {
+	t := c.peek()
+	return byte(c.ranged(t, c.number(), -0x80, 0xFF))
+}
+
+//nibble takes a 4 bit operand
+func (c *compiler) nibble() byte {
+	t := c.peek()
+	return byte(c.ranged(t, c.number(), 0, 0xF))
+}
+
+//resolve calls patch with the address t stands for, now or once the label is defined
+func (c *compiler) resolve(t token, patch func(addr int)) {
+
+	if t.text == "{" {
+		patch(int(c.calc()))
+		return
+	}
+	if v, ok := c.value(t); ok {
+		patch(v)
+		return
+	}
+	if !isName(t.text) {
+		c.fail(t.pos, "expected an address, got %q", t.text)
+	}
+	c.fixups = append(c.fixups, fixup{t, patch})
+}
+
+//addrInst compiles an instruction with a 12 bit address, op being its top nibble
+func (c *compiler) addrInst(op byte, t token) {
+
+	at := c.here
+	c.inst(op<<4, 0)
+	c.resolve(t, func(a int) {
+		if a < 0 || a > 0xFFF {
+			c.fail(t.pos, "address 0x%X is out of reach, use i := long", a)
+		}
+		c.rom[at-Base] |= byte(a >> 8)
+		c.rom[at-Base+1] = byte(a)
+	})
+}
+
+//patchJump points the jump at at to addr
+func (c *compiler) patchJump(at, addr int) {
+
+	if addr > 0xFFF {
+		c.fail(c.stmt.pos, "jump to 0x%X is out of reach", addr)
+	}
+	c.rom[at-Base] = 0x10 | byte(addr>>8)
+	c.rom[at-Base+1] = byte(addr)
+}
+
+//inst emits an instruction, mapping it to the line of the statement
+func (c *compiler) inst(hi, lo byte) {
+
+	p := c.stmt.pos
+	text := ""
+	if p.Line > 0 && p.Line <= len(c.lines) {
+		text = strings.TrimSpace(c.lines[p.Line-1])
+	}
+	c.prog.Source[uint16(c.here)] = vm.SourceLine{File: p.File, Line: p.Line, Text: text}
+	c.emit(hi, lo)
+}
+
+func (c *compiler) emit(bytes ...byte) {
+
+	for _, b := range bytes {
+		if c.here > 0xFFFF {
+			c.fail(c.stmt.pos, "program does not fit in memory")
+		}
+		for len(c.rom) <= c.here-Base {
+			c.rom = append(c.rom, 0)
+		}
+		c.rom[c.here-Base] = b
+		c.here++
+	}
+}
+
+//register parses v0-vf
+func register(s string) (int, bool) {
+
+	if len(s) != 2 || (s[0] != 'v' && s[0] != 'V') {
+		return 0, false
+	}
+	r, err := strconv.ParseUint(s[1:], 16, 8)
+	return int(r), err == nil
+}
+
+func isNumber(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+func isName(s string) bool {
+
+	if s == "" || isNumber(s) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(" {}()\"#:", s[i]) >= 0 {
+			return false
+		}
+	}
+	return true
+}
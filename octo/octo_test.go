@@ -0,0 +1,76 @@
+package octo
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/makoto126/term-atari/asm"
+)
+
+func TestCompile(t *testing.T) {
+
+	for _, tc := range []struct {
+		src  string
+		want []byte
+	}{
+		{"v0 := 5", []byte{0x60, 0x05}},
+		{": main v0 += 1 jump main", []byte{0x70, 0x01, 0x12, 0x00}},
+		{"jump end v0 := 1 : end", []byte{0x12, 0x04, 0x60, 0x01}},
+		{"i := data sprite v0 v1 1 : data 0xFF", []byte{0xA2, 0x04, 0xD0, 0x11, 0xFF}},
+		{"if v0 == 1 then v1 := 2", []byte{0x40, 0x01, 0x61, 0x02}},
+		{"if v0 != v2 then v1 := 2", []byte{0x50, 0x20, 0x61, 0x02}},
+		{"if v0 key then v1 := 2", []byte{0xE0, 0xA1, 0x61, 0x02}},
+		{
+			"if v0 == 1 begin v1 := 2 else v1 := 3 end",
+			[]byte{0x30, 0x01, 0x12, 0x08, 0x61, 0x02, 0x12, 0x0A, 0x61, 0x03},
+		},
+		{"if v0 == 1 begin v1 := 2 end", []byte{0x30, 0x01, 0x12, 0x06, 0x61, 0x02}},
+		{
+			"loop v0 += 1 while v0 != 10 again",
+			[]byte{0x70, 0x01, 0x40, 0x0A, 0x12, 0x08, 0x12, 0x00},
+		},
+		{"if v1 < v2 then v3 := 1", []byte{0x8F, 0x20, 0x8F, 0x17, 0x3F, 0x01, 0x63, 0x01}},
+		{"if v1 > 5 then v3 := 1", []byte{0x6F, 0x05, 0x8F, 0x15, 0x3F, 0x01, 0x63, 0x01}},
+		{"if v1 <= 5 then v3 := 1", []byte{0x6F, 0x05, 0x8F, 0x15, 0x4F, 0x01, 0x63, 0x01}},
+		{"if v1 >= v2 then v3 := 1", []byte{0x8F, 0x20, 0x8F, 0x17, 0x4F, 0x01, 0x63, 0x01}},
+		{":unpack 0xA data : data 0x12", []byte{0x60, 0xA2, 0x61, 0x04, 0x12}},
+		{":next n v0 := 7 i := n", []byte{0x60, 0x07, 0xA2, 0x01}},
+		{":const N 3 v2 := N", []byte{0x62, 0x03}},
+		{":macro twice a { a a } twice 0x55", []byte{0x55, 0x55}},
+		{":calc N { 2 * 3 + 1 } v0 := N", []byte{0x60, 0x08}},
+		{"i := long data : data", []byte{0xF0, 0x00, 0x02, 0x04}},
+	} {
+		prog, err := Compile("test.8o", []byte(tc.src))
+		if err != nil {
+			t.Errorf("%q: %v", tc.src, err)
+		} else if !bytes.Equal(prog.ROM, tc.want) {
+			t.Errorf("%q compiled to % X, want % X", tc.src, prog.ROM, tc.want)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+
+	for _, tc := range []struct {
+		src  string
+		msg  string
+		line int
+		col  int
+	}{
+		{"v0 := 1\nif v0 == 1 begin\nv1 := 2", "begin without end", 2, 1},
+		{"loop\nv0 += 1", "loop without again", 1, 1},
+		{"v0 := 1\n  jump nowhere", `undefined label "nowhere"`, 2, 8},
+		{"v0 := 1\nif v0 == 1 v1 := 2", "if without then or begin", 2, 1},
+	} {
+		_, err := Compile("test.8o", []byte(tc.src))
+		var e *asm.Error
+		if !errors.As(err, &e) {
+			t.Errorf("%q: got %v, want an *asm.Error", tc.src, err)
+			continue
+		}
+		if e.Msg != tc.msg || e.Pos.Line != tc.line || e.Pos.Col != tc.col {
+			t.Errorf("%q: got %d:%d %s, want %d:%d %s", tc.src, e.Pos.Line, e.Pos.Col, e.Msg, tc.line, tc.col, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+//Package romdb looks ROMs up by their SHA-1 hash.
+//It reads the programs.json format of the community chip-8-database,
+//https://github.com/chip-8/chip-8-database, and ships with entries for the bundled ROMs.
+package romdb
+
+import (
+	"bytes"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	"github.com/makoto126/term-atari/vm"
+)
+
+type (
+	//Platform is an interpreter a ROM can target
+	Platform struct {
+		ID       string
+		Name     string
+		Quirks   vm.Quirks
+		TickRate int
+	}
+
+	//Entry is everything known about one ROM
+	Entry struct {
+		Title       string
+		Authors     []string
+		Release     string
+		Description string
+		File        string
+		Platform    Platform
+		Quirks      vm.Quirks
+		//TickRate is the number of cycles per 60Hz frame
+		TickRate int
+		//Keys maps named controls (up, down, left, right, a, b and their player2 forms) to CHIP-8 keys
+		Keys map[string]byte
+	}
+
+	//DB is a set of entries keyed by SHA-1
+	DB map[string]Entry
+
+	program struct {
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Release     string         `json:"release"`
+		Authors     []string       `json:"authors"`
+		Roms        map[string]rom `json:"roms"`
+	}
+
+	rom struct {
+		File            string                     `json:"file"`
+		Description     string                     `json:"description"`
+		Release         string                     `json:"release"`
+		Authors         []string                   `json:"authors"`
+		Platforms       []string                   `json:"platforms"`
+		QuirkyPlatforms map[string]map[string]bool `json:"quirkyPlatforms"`
+		Tickrate        int                        `json:"tickrate"`
+		Keys            map[string]byte            `json:"keys"`
+	}
+)
+
+var (
+	//go:embed programs.json
+	programs []byte
+
+	//Platforms lists the platforms of the chip-8-database by id
+	Platforms = map[string]Platform{
+		"originalChip8": {"originalChip8", "COSMAC VIP", vm.QuirksVIP, 15},
+		"hybridVIP":     {"hybridVIP", "COSMAC VIP hybrid", vm.QuirksVIP, 15},
+		"modernChip8":   {"modernChip8", "Modern CHIP-8", vm.Quirks{}, 12},
+		"chip48":        {"chip48", "CHIP-48", vm.QuirksCHIP48, 30},
+		"superchip1":    {"superchip1", "SUPER-CHIP 1.0", vm.QuirksSCHIP1, 30},
+		"superchip":     {"superchip", "SUPER-CHIP 1.1", vm.QuirksSCHIP, 30},
+		"xochip":        {"xochip", "XO-CHIP", vm.QuirksXOCHIP, 100},
+	}
+)
+
+//Default returns the database of the bundled ROMs
+func Default() DB {
+
+	db := make(DB)
+	if err := db.Load(bytes.NewReader(programs)); err != nil {
+		panic(err)
+	}
+	return db
+}
+
+//Load adds the entries of a chip-8-database programs.json, replacing any with the same hash
+func (db DB) Load(r io.Reader) error {
+
+	var ps []program
+	if err := json.NewDecoder(r).Decode(&ps); err != nil {
+		return err
+	}
+
+	for _, p := range ps {
+		for hash, r := range p.Roms {
+			db[hash] = newEntry(p, r)
+		}
+	}
+	return nil
+}
+
+//Lookup finds the entry for a ROM image
+func (db DB) Lookup(data []byte) (Entry, bool) {
+
+	e, ok := db[Hash(data)]
+	return e, ok
+}
+
+//Hash returns the hex SHA-1 a ROM image is keyed by
+func Hash(data []byte) string {
+
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func newEntry(p program, r rom) Entry {
+
+	e := Entry{
+		Title:       p.Title,
+		Authors:     p.Authors,
+		Release:     p.Release,
+		Description: p.Description,
+		File:        r.File,
+		Platform:    Platforms["modernChip8"],
+		TickRate:    r.Tickrate,
+		Keys:        r.Keys,
+	}
+	if len(r.Authors) > 0 {
+		e.Authors = r.Authors
+	}
+	if r.Release != "" {
+		e.Release = r.Release
+	}
+	if r.Description != "" {
+		e.Description = r.Description
+	}
+
+	//the first supported platform wins, as the database lists them by preference
+	for _, id := range r.Platforms {
+		if pl, ok := Platforms[id]; ok {
+			e.Platform = pl
+			break
+		}
+	}
+
+	e.Quirks = e.Platform.Quirks
+	if q, ok := r.QuirkyPlatforms[e.Platform.ID]; ok {
+		override(&e.Quirks, q)
+	}
+	if e.TickRate == 0 {
+		e.TickRate = e.Platform.TickRate
+	}
+
+	return e
+}
+
+//override applies the quirks set in a quirkyPlatforms entry
+func override(q *vm.Quirks, m map[string]bool) {
+
+	for name, v := range m {
+		switch name {
+		case "shift":
+			q.Shift = v
+		case "memoryIncrementByX":
+			q.MemoryIncrementByX = v
+		case "memoryLeaveIUnchanged":
+			q.MemoryLeaveIUnchanged = v
+		case "wrap":
+			q.Wrap = v
+		case "jump":
+			q.Jump = v
+		case "vblank":
+			q.VBlank = v
+		case "logic":
+			q.Logic = v
+		}
+	}
+}
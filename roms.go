@@ -0,0 +1,126 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/makoto126/term-atari/asm"
+	"github.com/makoto126/term-atari/library"
+	"github.com/makoto126/term-atari/octo"
+	"github.com/makoto126/term-atari/romdb"
+	"github.com/makoto126/term-atari/vm"
+)
+
+//romFiles are the ROMs built into the binary
+//go:embed roms
+var romFiles embed.FS
+
+//program is a ROM to play, with the source it was built from if any
+type program struct {
+	//name is the file the program was read from and id the hash of that file
+	name        string
+	id          string
+	rom         []byte
+	source      vm.SourceMap
+	breakpoints []uint16
+}
+
+//builtin is the source of the ROMs built into the binary
+func builtin() library.RomSource {
+
+	sub, err := fs.Sub(romFiles, "roms")
+	if err != nil {
+		panic(err)
+	}
+	return library.Embedded(sub)
+}
+
+//openLibrary merges the built-in ROMs with the ROM files, directories and archives at paths
+func openLibrary(paths []string) (library.RomSource, error) {
+
+	sources := []library.RomSource{builtin()}
+	var files []string
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var src library.RomSource
+		switch {
+		case fi.IsDir():
+			src, err = library.Dir(path)
+		case library.IsArchive(path):
+			src, err = library.Archive(path)
+		default:
+			files = append(files, path)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, src)
+	}
+	if files != nil {
+		sources = append(sources, library.Files(files...))
+	}
+	return library.Merge(sources...), nil
+}
+
+//readRom reads a ROM file, falling back to the built-in ROMs by the same name
+func readRom(path string) ([]byte, error) {
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		if data, berr := builtin().Open(path); berr == nil {
+			return data, nil
+		}
+	}
+	return data, err
+}
+
+//readProgram reads a ROM, building it first if path is an assembly or Octo source
+func readProgram(path string) (program, error) {
+
+	data, err := readRom(path)
+	if err != nil {
+		return program{}, err
+	}
+	return build(path, data, os.ReadFile)
+}
+
+//openProgram reads a ROM of the library, building it first if it is a source
+func openProgram(rom library.Rom) (program, error) {
+
+	data, err := rom.Open()
+	if err != nil {
+		return program{}, err
+	}
+	return build(rom.Name, data, rom.Source.Open)
+}
+
+//build turns the file name holding data into a program, compiling it if it is a source.
+//Files the source includes are read with open.
+func build(name string, data []byte, open func(string) ([]byte, error)) (program, error) {
+
+	p := program{name: name, id: romdb.Hash(data), rom: data}
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".asm", ".s":
+		var err error
+		p.rom, p.source, err = asm.Assemble(name, data, open)
+		return p, err
+	case ".8o":
+		prog, err := octo.Compile(name, data)
+		if err != nil {
+			return program{}, err
+		}
+		p.rom, p.source = prog.ROM, prog.Source
+		for a := range prog.Breakpoints {
+			p.breakpoints = append(p.breakpoints, a)
+		}
+	}
+	return p, nil
+}
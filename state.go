@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/makoto126/term-atari/gui"
+	"github.com/makoto126/term-atari/library"
+	"github.com/makoto126/term-atari/vm"
+)
+
+//dataDir is where term-atari keeps its files, following the XDG base directory spec
+func dataDir() (string, error) {
+
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "term-atari"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "share", "term-atari"), nil
+}
+
+//loadStats reads the stats of the player from the data directory.
+//Without a data directory, or with a broken stats file, they start afresh.
+func loadStats() (*library.Stats, error) {
+
+	dir, err := dataDir()
+	if err != nil {
+		s, _ := library.LoadStats("")
+		return s, err
+	}
+	return library.LoadStats(filepath.Join(dir, "stats.json"))
+}
+
+//statePath is the file of a save state slot for the ROM with the given hash
+func statePath(hash string, slot int) (string, error) {
+
+	dir, err := dataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "states", fmt.Sprintf("%s.%d.state", hash, slot)), nil
+}
+
+//bindSlots wires the save state hotkeys of term to chip8
+func bindSlots(term *gui.Term, chip8 *vm.Chip8, hash string) {
+
+	save := func(slot int) {
+		chip8.Do(func() {
+			if err := saveState(chip8, hash, slot); err != nil {
+				term.Status(fmt.Sprintf("Save slot %d: %v", slot, err))
+				return
+			}
+			term.Status(fmt.Sprintf("Saved slot %d", slot))
+		})
+	}
+
+	load := func(slot int) {
+		chip8.Do(func() {
+			if err := loadState(chip8, hash, slot); err != nil {
+				term.Status(fmt.Sprintf("Load slot %d: %v", slot, err))
+				return
+			}
+			term.Status(fmt.Sprintf("Loaded slot %d", slot))
+		})
+	}
+
+	term.OnSlot(save, load)
+}
+
+func saveState(chip8 *vm.Chip8, hash string, slot int) error {
+
+	path, err := statePath(hash, slot)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := chip8.SaveState(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func loadState(chip8 *vm.Chip8, hash string, slot int) error {
+
+	path, err := statePath(hash, slot)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return errors.New("empty slot")
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return chip8.LoadState(f)
+}
@@ -4,16 +4,20 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"sync"
 	"time"
 )
 
-var (
-	cpuFreq     = 500
-	cpuDuration = time.Duration(1000/cpuFreq) * time.Millisecond
+const (
+	memSize = 0x10000
 
 	timerFreq     = 60
-	timerDuration = time.Duration(1000/timerFreq) * time.Millisecond
+	timerDuration = time.Second / timerFreq
+	//defaultTickRate is the cycles per frame of a 500Hz CPU
+	defaultTickRate = 500 / timerFreq
+)
 
+var (
 	fontset = []byte{
 		0xF0, 0x90, 0x90, 0x90, 0xF0, // 0
 		0x20, 0x60, 0x20, 0x20, 0x70, // 1
@@ -33,86 +37,171 @@ var (
 		0xF0, 0x80, 0xF0, 0x80, 0x80, // F
 	}
 
-	funcmap = map[uint16]func(*Chip8){
+	//bigFontset is the SCHIP 8x10 font, stored right after fontset
+	bigFontset = []byte{
+		0xFF, 0xFF, 0xC3, 0xC3, 0xC3, 0xC3, 0xC3, 0xC3, 0xFF, 0xFF, // 0
+		0x18, 0x78, 0x78, 0x18, 0x18, 0x18, 0x18, 0x18, 0xFF, 0xFF, // 1
+		0xFF, 0xFF, 0x03, 0x03, 0xFF, 0xFF, 0xC0, 0xC0, 0xFF, 0xFF, // 2
+		0xFF, 0xFF, 0x03, 0x03, 0xFF, 0xFF, 0x03, 0x03, 0xFF, 0xFF, // 3
+		0xC3, 0xC3, 0xC3, 0xC3, 0xFF, 0xFF, 0x03, 0x03, 0x03, 0x03, // 4
+		0xFF, 0xFF, 0xC0, 0xC0, 0xFF, 0xFF, 0x03, 0x03, 0xFF, 0xFF, // 5
+		0xFF, 0xFF, 0xC0, 0xC0, 0xFF, 0xFF, 0xC3, 0xC3, 0xFF, 0xFF, // 6
+		0xFF, 0xFF, 0x03, 0x03, 0x06, 0x0C, 0x18, 0x18, 0x18, 0x18, // 7
+		0xFF, 0xFF, 0xC3, 0xC3, 0xFF, 0xFF, 0xC3, 0xC3, 0xFF, 0xFF, // 8
+		0xFF, 0xFF, 0xC3, 0xC3, 0xFF, 0xFF, 0x03, 0x03, 0xFF, 0xFF, // 9
+		0x7E, 0xFF, 0xC3, 0xC3, 0xC3, 0xFF, 0xFF, 0xC3, 0xC3, 0xC3, // A
+		0xFC, 0xFC, 0xC3, 0xC3, 0xFC, 0xFC, 0xC3, 0xC3, 0xFC, 0xFC, // B
+		0x3C, 0xFF, 0xC3, 0xC0, 0xC0, 0xC0, 0xC0, 0xC3, 0xFF, 0x3C, // C
+		0xFC, 0xFE, 0xC3, 0xC3, 0xC3, 0xC3, 0xC3, 0xC3, 0xFE, 0xFC, // D
+		0xFF, 0xFF, 0xC0, 0xC0, 0xFF, 0xFF, 0xC0, 0xC0, 0xFF, 0xFF, // E
+		0xFF, 0xFF, 0xC0, 0xC0, 0xFF, 0xFF, 0xC0, 0xC0, 0xC0, 0xC0, // F
+	}
+
+	funcmap = map[uint16]instruction{
 		//00E0: Clears the screen.
-		0x00E0: func(c *Chip8) {
-			c.Clear()
+		0x00E0: {"CLS", func(c *Chip8) {
+			c.display.clear(c.plane)
 			c.pc += 2
-		},
+		}},
 		//00EE: Returns from a subroutine.
-		0x00EE: func(c *Chip8) {
+		0x00EE: {"RET", func(c *Chip8) {
+			if c.sp == 0 {
+				c.fault = fmt.Errorf("stack underflow at %04X", c.pc)
+				return
+			}
 			c.sp--
 			c.pc = c.stack[c.sp] + 2
-		},
+		}},
+		//00CN: Scrolls the display down by N pixels. (SCHIP)
+		0x00C0: {"SCD nibble", func(c *Chip8) {
+			c.display.scroll(c.plane, 0, int(c.opcode&0x000F))
+			c.pc += 2
+		}},
+		//00DN: Scrolls the selected planes up by N pixels. (XO-CHIP)
+		0x00D0: {"SCU nibble", func(c *Chip8) {
+			c.display.scroll(c.plane, 0, -int(c.opcode&0x000F))
+			c.pc += 2
+		}},
+		//00FB: Scrolls the display right by 4 pixels. (SCHIP)
+		0x00FB: {"SCR", func(c *Chip8) {
+			c.display.scroll(c.plane, 4, 0)
+			c.pc += 2
+		}},
+		//00FC: Scrolls the display left by 4 pixels. (SCHIP)
+		0x00FC: {"SCL", func(c *Chip8) {
+			c.display.scroll(c.plane, -4, 0)
+			c.pc += 2
+		}},
+		//00FD: Exits the interpreter. (SCHIP)
+		0x00FD: {"EXIT", func(c *Chip8) {
+			c.exit = true
+		}},
+		//00FE: Disables the 128x64 high resolution mode. (SCHIP)
+		0x00FE: {"LOW", func(c *Chip8) {
+			c.display.setHires(false)
+			c.pc += 2
+		}},
+		//00FF: Enables the 128x64 high resolution mode. (SCHIP)
+		0x00FF: {"HIGH", func(c *Chip8) {
+			c.display.setHires(true)
+			c.pc += 2
+		}},
 		//1NNN: Jumps to address NNN.
-		0x1000: func(c *Chip8) {
+		0x1000: {"JP addr", func(c *Chip8) {
 			c.pc = c.getNNN()
-		},
+		}},
 		//2NNN: Calls subroutine at NNN.
-		0x2000: func(c *Chip8) {
+		0x2000: {"CALL addr", func(c *Chip8) {
+			if int(c.sp) == len(c.stack) {
+				c.fault = fmt.Errorf("stack overflow at %04X", c.pc)
+				return
+			}
 			c.stack[c.sp] = c.pc
 			c.sp++
 			c.pc = c.getNNN()
-		},
+		}},
 		//3XNN: Skips the next instruction if VX equals NN.
 		//(Usually the next instruction is a jump to skip a code block)
-		0x3000: func(c *Chip8) {
+		0x3000: {"SE Vx, byte", func(c *Chip8) {
 			if c.getVX() == c.getNN() {
-				c.pc += 4
+				c.skip()
 			} else {
 				c.pc += 2
 			}
-		},
+		}},
 		//4XNN: Skips the next instruction if VX doesn't equal NN.
 		//(Usually the next instruction is a jump to skip a code block)
-		0x4000: func(c *Chip8) {
+		0x4000: {"SNE Vx, byte", func(c *Chip8) {
 			if c.getVX() != c.getNN() {
-				c.pc += 4
+				c.skip()
 			} else {
 				c.pc += 2
 			}
-		},
+		}},
 		//5XY0: Skips the next instruction if VX equals VY.
 		//(Usually the next instruction is a jump to skip a code block)
-		0x5000: func(c *Chip8) {
+		0x5000: {"SE Vx, Vy", func(c *Chip8) {
 			if c.getVX() == c.getVY() {
-				c.pc += 4
+				c.skip()
 			} else {
 				c.pc += 2
 			}
-		},
+		}},
+		//5XY2: Stores VX to VY (in either order) in memory starting at address I. I is left unmodified. (XO-CHIP)
+		0x5002: {"SAVE Vx, Vy", func(c *Chip8) {
+			for n, r := range c.span() {
+				c.store(c.index+uint16(n), c.getV(r))
+			}
+			c.pc += 2
+		}},
+		//5XY3: Fills VX to VY (in either order) with values from memory starting at address I. I is left unmodified. (XO-CHIP)
+		0x5003: {"LOAD Vx, Vy", func(c *Chip8) {
+			for n, r := range c.span() {
+				c.setV(r, c.load(c.index+uint16(n)))
+			}
+			c.pc += 2
+		}},
 		//6XNN: Sets VX to NN.
-		0x6000: func(c *Chip8) {
+		0x6000: {"LD Vx, byte", func(c *Chip8) {
 			c.setVX(c.getNN())
 			c.pc += 2
-		},
+		}},
 		//7XNN: Adds NN to VX. (Carry flag is not changed)
-		0x7000: func(c *Chip8) {
+		0x7000: {"ADD Vx, byte", func(c *Chip8) {
 			c.setVX(c.getVX() + c.getNN())
 			c.pc += 2
-		},
+		}},
 		//8XY0: Sets VX to the value of VY.
-		0x8000: func(c *Chip8) {
+		0x8000: {"LD Vx, Vy", func(c *Chip8) {
 			c.setVX(c.getVY())
 			c.pc += 2
-		},
+		}},
 		//8XY1: Sets VX to VX or VY. (Bitwise OR operation)
-		0x8001: func(c *Chip8) {
+		0x8001: {"OR Vx, Vy", func(c *Chip8) {
 			c.setVX(c.getVX() | c.getVY())
+			if c.quirks.Logic {
+				c.setVF(0)
+			}
 			c.pc += 2
-		},
+		}},
 		//8XY2: Sets VX to VX and VY. (Bitwise AND operation)
-		0x8002: func(c *Chip8) {
+		0x8002: {"AND Vx, Vy", func(c *Chip8) {
 			c.setVX(c.getVX() & c.getVY())
+			if c.quirks.Logic {
+				c.setVF(0)
+			}
 			c.pc += 2
-		},
+		}},
 		//8XY3: Sets VX to VX xor VY.
-		0x8003: func(c *Chip8) {
+		0x8003: {"XOR Vx, Vy", func(c *Chip8) {
 			c.setVX(c.getVX() ^ c.getVY())
+			if c.quirks.Logic {
+				c.setVF(0)
+			}
 			c.pc += 2
-		},
+		}},
 		//8XY4: Adds VY to VX. VF is set to 1 when there's a carry, and to 0 when there isn't.
-		0x8004: func(c *Chip8) {
+		0x8004: {"ADD Vx, Vy", func(c *Chip8) {
 			if 0xFF-c.getVX() < c.getVY() {
 				c.setVF(1)
 			} else {
@@ -120,9 +209,9 @@ var (
 			}
 			c.setVX(c.getVX() + c.getVY())
 			c.pc += 2
-		},
+		}},
 		//8XY5: VY is subtracted from VX. VF is set to 0 when there's a borrow, and 1 when there isn't.
-		0x8005: func(c *Chip8) {
+		0x8005: {"SUB Vx, Vy", func(c *Chip8) {
 			if c.getVX() < c.getVY() {
 				c.setVF(0)
 			} else {
@@ -130,15 +219,20 @@ var (
 			}
 			c.setVX(c.getVX() - c.getVY())
 			c.pc += 2
-		},
-		//8XY6: Stores the least significant bit of VX in VF and then shifts VX to the right by 1.
-		0x8006: func(c *Chip8) {
-			c.setVF(c.getVX() & 0x01)
-			c.setVX(c.getVX() >> 1)
+		}},
+		//8XY6: Shifts VY to the right by 1 and stores the result in VX (VX itself with the shift quirk).
+		//VF is set to the least significant bit before the shift.
+		0x8006: {"SHR Vx, Vy", func(c *Chip8) {
+			v := c.getVY()
+			if c.quirks.Shift {
+				v = c.getVX()
+			}
+			c.setVX(v >> 1)
+			c.setVF(v & 0x01)
 			c.pc += 2
-		},
+		}},
 		//8XY7: Sets VX to VY minus VX. VF is set to 0 when there's a borrow, and 1 when there isn't.
-		0x8007: func(c *Chip8) {
+		0x8007: {"SUBN Vx, Vy", func(c *Chip8) {
 			if c.getVX() > c.getVY() {
 				c.setVF(0)
 			} else {
@@ -146,133 +240,198 @@ var (
 			}
 			c.setVX(c.getVY() - c.getVX())
 			c.pc += 2
-		},
-		//8XYE: Stores the most significant bit of VX in VF and then shifts VX to the left by 1.
-		0x800E: func(c *Chip8) {
-			c.setVF(c.getVX() & 0x80)
-			c.setVX(c.getVX() << 1)
+		}},
+		//8XYE: Shifts VY to the left by 1 and stores the result in VX (VX itself with the shift quirk).
+		//VF is set to the most significant bit before the shift.
+		0x800E: {"SHL Vx, Vy", func(c *Chip8) {
+			v := c.getVY()
+			if c.quirks.Shift {
+				v = c.getVX()
+			}
+			c.setVX(v << 1)
+			c.setVF(v >> 7)
 			c.pc += 2
-		},
+		}},
 		//9XY0: Skips the next instruction if VX doesn't equal VY. (Usually the next instruction is a jump to skip a code block)
-		0x9000: func(c *Chip8) {
+		0x9000: {"SNE Vx, Vy", func(c *Chip8) {
 			if c.getVX() != c.getVY() {
-				c.pc += 4
+				c.skip()
 			} else {
 				c.pc += 2
 			}
-		},
+		}},
 		//ANNN: Sets I to the address NNN.
-		0xA000: func(c *Chip8) {
+		0xA000: {"LD I, addr", func(c *Chip8) {
 			c.index = c.getNNN()
 			c.pc += 2
-		},
-		//BNNN: Jumps to the address NNN plus V0.
-		0xB000: func(c *Chip8) {
-			c.pc = c.getNNN() + uint16(c.register[0])
-		},
+		}},
+		//BNNN: Jumps to the address NNN plus V0. (XNN plus VX with the jump quirk)
+		0xB000: {"JP V0, addr", func(c *Chip8) {
+			if c.quirks.Jump {
+				c.pc = c.getNNN() + uint16(c.getVX())
+			} else {
+				c.pc = c.getNNN() + uint16(c.getV(0))
+			}
+		}},
 		//CXNN: Sets VX to the result of a bitwise and operation on a random number (Typically: 0 to 255) and NN.
-		0xC000: func(c *Chip8) {
-			c.setVX(byte(rand.Intn(256)) & c.getNN())
+		0xC000: {"RND Vx, byte", func(c *Chip8) {
+			c.setVX(byte(c.rand.Intn(256)) & c.getNN())
 			c.pc += 2
-		},
+		}},
 		//DXYN: Draws a sprite at coordinate (VX, VY) that has a width of 8 pixels and a height of N pixels.
 		//Each row of 8 pixels is read as bit-coded starting from memory location I;
 		//I value doesn’t change after the execution of this instruction.
 		//As described above, VF is set to 1 if any screen pixels are flipped from set to unset
 		//when the sprite is drawn, and to 0 if that doesn’t happen
-		0xD000: func(c *Chip8) {
+		//DXY0: Draws a 16x16 sprite read as 32 bytes, two per row. (SCHIP)
+		//With several planes selected, one sprite is read for each plane in turn. (XO-CHIP)
+		0xD000: {"DRW Vx, Vy, nibble", func(c *Chip8) {
+			c.vblank = c.quirks.VBlank
 			x, y := int(c.getVX()), int(c.getVY())
 			h := c.opcode & 0x000F
-			c.setVF(c.Draw(x, y, c.mem[c.index:c.index+h]))
+			if h == 0 {
+				c.setVF(c.display.drawWide(c.plane, c.quirks.Wrap, x, y, c.loads(c.index, 32*c.planes())))
+			} else {
+				c.setVF(c.display.draw(c.plane, c.quirks.Wrap, x, y, c.loads(c.index, int(h)*c.planes())))
+			}
 			c.pc += 2
-		},
+		}},
 		//EX9E: Skips the next instruction if the key stored in VX is pressed. (Usually the next instruction is a jump to skip a code block)
-		0xE09E: func(c *Chip8) {
+		0xE09E: {"SKP Vx", func(c *Chip8) {
 			if c.IsPressed(c.getVX()) {
-				c.pc += 4
+				c.skip()
 			} else {
 				c.pc += 2
 			}
-		},
+		}},
 		//EXA1: Skips the next instruction if the key stored in VX isn't pressed. (Usually the next instruction is a jump to skip a code block)
-		0xE0A1: func(c *Chip8) {
+		0xE0A1: {"SKNP Vx", func(c *Chip8) {
 			if !c.IsPressed(c.getVX()) {
-				c.pc += 4
+				c.skip()
 			} else {
 				c.pc += 2
 			}
-		},
+		}},
+		//F000 NNNN: Sets I to the 16-bit address NNNN stored in the next word. (XO-CHIP)
+		0xF000: {"LD I, long", func(c *Chip8) {
+			c.index = uint16(c.mem[c.pc+2])<<8 | uint16(c.mem[c.pc+3])
+			c.pc += 4
+		}},
+		//FN01: Selects the drawing planes by the bitmask N. (XO-CHIP)
+		0xF001: {"PLANE plane", func(c *Chip8) {
+			c.plane = byte((c.opcode&0x0F00)>>8) & 0x03
+			c.pc += 2
+		}},
+		//F002: Loads the 16-byte audio pattern buffer from memory starting at address I. (XO-CHIP)
+		0xF002: {"AUDIO", func(c *Chip8) {
+			copy(c.pattern[:], c.loads(c.index, len(c.pattern)))
+			c.pc += 2
+		}},
 		//FX07: Sets VX to the value of the delay timer.
-		0xF007: func(c *Chip8) {
+		0xF007: {"LD Vx, DT", func(c *Chip8) {
 			c.setVX(c.delayTimer)
 			c.pc += 2
-		},
+		}},
 		//FX0A: A key press is awaited, and then stored in VX. (Blocking Operation. All instruction halted until next key event)
-		0xF00A: func(c *Chip8) {
-			c.setVX(c.WaitKey())
-			c.pc += 2
-		},
+		//The key is stored once it is released, as on the COSMAC VIP. The machine goes on running
+		//meanwhile, the timers counting down, and PC stays on FX0A until the wait is over.
+		0xF00A: {"LD Vx, K", func(c *Chip8) {
+			c.keyWait = true
+		}},
 		//FX15: Sets the delay timer to VX.
-		0xF015: func(c *Chip8) {
+		0xF015: {"LD DT, Vx", func(c *Chip8) {
 			c.delayTimer = c.getVX()
 			c.pc += 2
-		},
+		}},
 		//FX18: Sets the sound timer to VX.
-		0xF018: func(c *Chip8) {
+		0xF018: {"LD ST, Vx", func(c *Chip8) {
 			c.soundTimer = c.getVX()
 			c.pc += 2
-		},
-		//FX1E: Adds VX to I.
-		0xF01E: func(c *Chip8) {
+		}},
+		//FX1E: Adds VX to I. VF is left alone, as in Octo: above 0xFFF is memory too in XO-CHIP.
+		0xF01E: {"ADD I, Vx", func(c *Chip8) {
 			c.index += uint16(c.getVX())
-			if c.index > 0xFFF {
-				c.setVF(1)
-				c.index &= 0xFFF
-			} else {
-				c.setVF(0)
-			}
 			c.pc += 2
-		},
+		}},
 		//FX29: Sets I to the location of the sprite for the character in VX. Characters 0-F (in hexadecimal) are represented by a 4x5 font.
-		0xF029: func(c *Chip8) {
+		0xF029: {"LD F, Vx", func(c *Chip8) {
 			c.index = uint16(c.getVX() * 5)
 			c.pc += 2
-		},
+		}},
+		//FX30: Sets I to the location of the 8x10 sprite for the digit in VX. (SCHIP)
+		0xF030: {"LD HF, Vx", func(c *Chip8) {
+			c.index = uint16(len(fontset)) + uint16(c.getVX()&0x0F)*10
+			c.pc += 2
+		}},
 		//FX33: Stores the binary-coded decimal representation of VX,
 		//with the most significant of three digits at the address in I,
 		//the middle digit at I plus 1, and the least significant digit at I plus 2.
 		//(In other words, take the decimal representation of VX,
 		//place the hundreds digit in memory at location in I, the tens digit at location I+1,
 		//and the ones digit at location I+2.)
-		0xF033: func(c *Chip8) {
+		0xF033: {"LD B, Vx", func(c *Chip8) {
 			x := c.getVX()
-			c.mem[c.index] = x / 100
-			c.mem[c.index+1] = x % 100 / 10
-			c.mem[c.index+2] = x % 10
-			c.pc += 2
-		},
-		//FX55: Stores V0 to VX (including VX) in memory starting at address I. The offset from I is increased by 1 for each value written, but I itself is left unmodified.
-		0xF055: func(c *Chip8) {
-			for i := uint16(0); i <= (c.opcode&0x0F00)>>8; i++ {
-				c.mem[c.index+i] = c.register[i]
+			c.store(c.index, x/100)
+			c.store(c.index+1, x%100/10)
+			c.store(c.index+2, x%10)
+			c.pc += 2
+		}},
+		//FX55: Stores V0 to VX (including VX) in memory starting at address I.
+		//I is then increased by X+1 (by X, or left unmodified, with the memory quirks).
+		0xF055: {"LD [I], Vx", func(c *Chip8) {
+			x := (c.opcode & 0x0F00) >> 8
+			for i := uint16(0); i <= x; i++ {
+				c.store(c.index+i, c.getV(i))
 			}
+			c.advanceIndex(x)
 			c.pc += 2
-		},
+		}},
 		//FX65: Fills V0 to VX (including VX) with values from memory starting at address I.
-		//The offset from I is increased by 1 for each value written, but I itself is left unmodified.
-		0xF065: func(c *Chip8) {
-			for i := uint16(0); i <= (c.opcode&0x0F00)>>8; i++ {
-				c.register[i] = c.mem[c.index+i]
+		//I is then increased by X+1 (by X, or left unmodified, with the memory quirks).
+		0xF065: {"LD Vx, [I]", func(c *Chip8) {
+			x := (c.opcode & 0x0F00) >> 8
+			for i := uint16(0); i <= x; i++ {
+				c.setV(i, c.load(c.index+i))
 			}
+			c.advanceIndex(x)
+			c.pc += 2
+		}},
+		//FX3A: Sets the audio pattern playback pitch to VX. (XO-CHIP)
+		0xF03A: {"PITCH Vx", func(c *Chip8) {
+			c.pitch = c.getVX()
 			c.pc += 2
-		},
+		}},
+		//FX75: Stores V0 to VX (including VX) in the RPL user flags. (SCHIP X < 8, XO-CHIP X < 16)
+		0xF075: {"LD R, Vx", func(c *Chip8) {
+			for i := uint16(0); i <= (c.opcode&0x0F00)>>8 && int(i) < len(c.rpl); i++ {
+				c.rpl[i] = c.getV(i)
+			}
+			c.pc += 2
+		}},
+		//FX85: Fills V0 to VX (including VX) from the RPL user flags. (SCHIP X < 8, XO-CHIP X < 16)
+		0xF085: {"LD Vx, R", func(c *Chip8) {
+			for i := uint16(0); i <= (c.opcode&0x0F00)>>8 && int(i) < len(c.rpl); i++ {
+				c.setV(i, c.rpl[i])
+			}
+			c.pc += 2
+		}},
 	}
 )
 
 type (
+	//instruction is an entry of the instruction table
+	instruction struct {
+		//asm is the Cowgod style assembly template, where the operands
+		//Vx, Vy, addr, byte, nibble, long and plane stand for the fields of the opcode,
+		//long being written with a "long" prefix as in LD I, long 0x1234
+		asm  string
+		exec func(*Chip8)
+	}
+
+	//moniter shows the display. It is presented whenever the display changed,
+	//at most once a frame, on the goroutine running the machine.
 	moniter interface {
-		Clear()
-		Draw(int, int, []byte) byte
+		Present(*Display)
 	}
 
 	sounder interface {
@@ -281,12 +440,12 @@ type (
 
 	inputer interface {
 		IsPressed(byte) bool
-		WaitKey() byte
 	}
 
 	//Chip8 is the atari vm
 	Chip8 struct {
 		mem        []byte
+		program    []byte
 		opcode     uint16
 		register   [16]byte
 		index      uint16
@@ -295,82 +454,361 @@ type (
 		soundTimer byte
 		stack      [16]uint16
 		sp         uint16
-
-		codeKey   uint16
-		cpuTick   <-chan time.Time
-		timerTick <-chan time.Time
+		rpl        [16]byte
+		exit       bool
+		plane      byte
+		pattern    [16]byte
+		pitch      byte
+		display    Display
+
+		quirks   Quirks
+		codeKey  uint16
+		clock    Clock
+		rand     *rand.Rand
+		perFrame int
+		speed    float64
+		//ticker paces Loop, ticking every tickPeriod
+		ticker     Ticker
+		tickPeriod time.Duration
+		//suspended stops frames like paused, but for the frontend rather than the debugger
+		suspended bool
+		//presented is when the display was last presented, for fast speeds to do so at most 60 times a second
+		presented time.Time
+		//frameCycle counts the cycles run since the timers last counted down
+		frameCycle int
+		//vblank is set by DXYN under the VBlank quirk, idling the machine until the next frame
+		vblank bool
+		//keyWait is set while FX0A waits for a key, which is key once keyDown is set
+		keyWait bool
+		keyDown bool
+		key     byte
+		cmd     chan func()
+
+		rewind      *Rewind
+		rewindUntil time.Time
+
+		debug       func(Debug)
+		paused      bool
+		resumed     bool
+		breakpoints map[uint16]cond
+		conds       []condition
+		watches     []watchpoint
+		hits        []watchpoint
+		reason      string
+		inst        uint16
+		source      SourceMap
+		err         error
+		//fault is the error the instruction executing ran into, which exec returns
+		fault error
 
 		moniter
 		sounder
 		inputer
 
 		quit <-chan struct{}
+		//looping is set while Loop runs, done closed once it returned
+		loopMu  sync.Mutex
+		looping bool
+		done    chan struct{}
 	}
 )
 
 //Init the emulator
-func (c *Chip8) Init(m moniter, s sounder, i inputer, quit <-chan struct{}) {
+func (c *Chip8) Init(m moniter, s sounder, i inputer, quit <-chan struct{}, q Quirks) {
 	c.moniter = m
 	c.sounder = s
 	c.inputer = i
 	c.quit = quit
+	c.quirks = q
+
+	c.perFrame = defaultTickRate
+	c.speed = 1
+	c.cmd = make(chan func(), 8)
+	c.breakpoints = make(map[uint16]cond)
+	c.setClock(WallClock)
+
+	c.reset()
+}
 
-	c.cpuTick = time.Tick(cpuDuration)
-	c.timerTick = time.Tick(timerDuration)
+//reset puts the machine in its power-on state, with only the fonts in memory.
+//The persistent SCHIP flags are kept, as they are on the calculator.
+func (c *Chip8) reset() {
 
 	c.pc = 0x200
-	c.mem = make([]byte, 4096)
-	copy(c.mem[:80], fontset)
-	rand.Seed(time.Now().UnixNano())
+	c.mem = make([]byte, memSize)
+	copy(c.mem, fontset)
+	copy(c.mem[len(fontset):], bigFontset)
+
+	c.register = [16]byte{}
+	c.index = 0
+	c.stack = [16]uint16{}
+	c.sp = 0
+	c.delayTimer, c.soundTimer = 0, 0
+	c.exit = false
+	c.frameCycle = 0
+	c.vblank, c.keyWait, c.keyDown = false, false, false
+
+	c.plane = 1
+	c.pattern = [16]byte{}
+	c.pitch = 64
+	c.display = Display{dirty: true}
+}
+
+//SetTickRate runs n cycles per 60Hz frame instead of the default 500Hz clock.
+//While the machine runs it is only safe through Do.
+func (c *Chip8) SetTickRate(n int) {
+	if n < 1 {
+		n = 1
+	}
+	c.perFrame = n
+	if c.frameCycle >= n {
+		c.frameCycle = 0
+	}
+	c.report()
+}
 
-	c.Clear()
+//SetClock paces Loop with k instead of the wall clock.
+//The random numbers of CXNN are seeded from its time, so a VirtualClock makes whole runs deterministic.
+//Its ticker starts at once: the ticks of a VirtualClock advanced before Loop runs wait for Loop to take them.
+func (c *Chip8) SetClock(k Clock) {
+	c.setClock(k)
+	c.retick()
+}
+
+//setClock tells the time from k, leaving Loop to start its ticker
+func (c *Chip8) setClock(k Clock) {
+
+	if c.ticker != nil {
+		c.ticker.Stop()
+		c.ticker = nil
+	}
+	c.clock = k
+	c.rand = rand.New(rand.NewSource(k.Now().UnixNano()))
+}
+
+//retick starts the ticker pacing Loop, again if the period of a frame changed
+func (c *Chip8) retick() {
+
+	if p := c.period(); c.ticker == nil || p != c.tickPeriod {
+		if c.ticker != nil {
+			c.ticker.Stop()
+		}
+		c.ticker, c.tickPeriod = c.clock.NewTicker(p), p
+	}
 }
 
 //Load a game
 func (c *Chip8) Load(r io.Reader) error {
-	_, err := r.Read(c.mem[512:])
+	n, err := r.Read(c.mem[512:])
+	c.program = append(c.program[:0], c.mem[512:512+n]...)
 	return err
 }
 
-//Loop the game
+//Reset restarts the game that was loaded
+func (c *Chip8) Reset() {
+	c.Do(func() {
+		c.reset()
+		copy(c.mem[512:], c.program)
+		c.present()
+		c.report()
+	})
+}
+
+//Loop the game: every tick of the clock, 60 times a second at normal speed,
+//it runs the cycles of a frame, then shows the display. Unthrottled, frames run in between the ticks.
+//Everything happens on the calling goroutine, in between ticks for the functions passed to Do.
 func (c *Chip8) Loop() error {
 
-	go c.countDown()
+	c.loopMu.Lock()
+	c.looping, c.done = true, make(chan struct{})
+	c.loopMu.Unlock()
+	defer func() {
+		c.loopMu.Lock()
+		c.looping = false
+		close(c.done)
+		c.loopMu.Unlock()
+	}()
+
+	defer func() {
+		if c.ticker != nil {
+			c.ticker.Stop()
+			c.ticker = nil
+		}
+	}()
+	c.report()
 
-	var err error
 loop:
 	for {
-		select {
-		case <-c.cpuTick:
-			c.fetch()
-
-			c.decode()
+		c.retick()
+		//unthrottled, frames run back to back and the ticks only present the display
+		var fast chan time.Time
+		if c.speed == Unthrottled && c.running() {
+			fast = always
+		}
 
-			err = c.exec()
-			if err != nil {
-				break loop
+		select {
+		case <-c.ticker.C():
+			if fast != nil {
+				c.present()
+			} else {
+				c.frame()
 			}
+		case <-fast:
+			c.step()
+		case f := <-c.cmd:
+			f()
 		case <-c.quit:
 			break loop
 		}
+
+		if c.err != nil || c.exit {
+			break
+		}
 	}
 
-	return err
+	return c.err
+}
+
+//frame runs a frame with step and presents the display, at most 60 times a second at fast speeds
+func (c *Chip8) frame() {
+
+	c.step()
+	if c.speed > 1 {
+		now := c.clock.Now()
+		if now.Sub(c.presented) < timerDuration {
+			return
+		}
+		c.presented = now
+	}
+	c.present()
+}
+
+//step runs the rest of the cycles of the current frame, unless the machine is paused
+//or rewinding, and records it for rewinding
+func (c *Chip8) step() {
+
+	if c.running() {
+		c.err = c.advance(c.perFrame-c.frameCycle, true)
+		if c.rewind != nil && c.frameCycle == 0 {
+			c.record()
+		}
+	}
+}
+
+//running tells whether frames run, the machine being neither paused, suspended nor held after a rewind step
+func (c *Chip8) running() bool {
+	return !c.paused && !c.suspended && !c.clock.Now().Before(c.rewindUntil)
+}
+
+//Run executes cycles cycles at once, as fast as it goes, for previews of ROMs and headless runs.
+//The timers count down before every FrameCycles cycles, counting on from the last Run.
+//The display is presented once, at the end.
+//Run stops early if the program exits or runs into a bad opcode.
+func (c *Chip8) Run(cycles int) error {
+
+	defer c.present()
+	return c.advance(cycles, false)
+}
+
+//advance runs n cycles, counting the timers down whenever a frame begins.
+//A cycle executes an instruction, unless the machine waits for the next frame or a key.
+//With breaks set, breakpoints and watchpoints pause the machine, stopping advance early.
+func (c *Chip8) advance(n int, breaks bool) error {
+
+	for ; n > 0 && !c.exit; n-- {
+		if c.frameCycle == 0 {
+			c.tick()
+			c.vblank = false
+		}
+		if c.waiting() {
+			c.frameCycle = (c.frameCycle + 1) % c.perFrame
+			//FX0A writes VX as its wait ends, with PC and c.inst still on it
+			if breaks && c.watched() {
+				c.paused = true
+				c.report()
+				return nil
+			}
+			continue
+		}
+		if breaks && c.breaks() {
+			c.paused = true
+			c.report()
+			return nil
+		}
+
+		c.frameCycle = (c.frameCycle + 1) % c.perFrame
+		if err := c.cycle(); err != nil {
+			return err
+		}
+		if breaks && c.watched() {
+			c.paused = true
+			c.report()
+			return nil
+		}
+	}
+	return nil
+}
+
+//waiting tells whether the machine idles this cycle: after drawing under the VBlank quirk,
+//or while FX0A waits for a key to be pressed then released
+func (c *Chip8) waiting() bool {
+
+	if c.vblank {
+		return true
+	}
+	if !c.keyWait {
+		return false
+	}
+
+	if !c.keyDown {
+		for k := byte(0); k < 16; k++ {
+			if c.IsPressed(k) {
+				c.key, c.keyDown = k, true
+				break
+			}
+		}
+	} else if !c.IsPressed(c.key) {
+		c.setVX(c.key)
+		c.pc += 2
+		c.keyWait, c.keyDown = false, false
+	}
+	return true
+}
+
+//FrameCycles is how many cycles run in a 60Hz frame, as SetTickRate set it
+func (c *Chip8) FrameCycles() int {
+	return c.perFrame
+}
+
+//Exited tells whether the program ran the SCHIP exit instruction
+func (c *Chip8) Exited() bool {
+	return c.exit
+}
+
+//cycle executes one instruction
+func (c *Chip8) cycle() error {
+
+	c.inst = c.pc
+	c.fetch()
+
+	c.decode()
+
+	return c.exec()
 }
 
 func (c *Chip8) getVX() byte {
-	return c.register[(c.opcode&0x0F00)>>8]
+	return c.getV((c.opcode & 0x0F00) >> 8)
 }
 func (c *Chip8) setVX(b byte) {
-	c.register[(c.opcode&0x0F00)>>8] = b
+	c.setV((c.opcode&0x0F00)>>8, b)
 }
 
 func (c *Chip8) getVY() byte {
-	return c.register[(c.opcode&0x00F0)>>4]
+	return c.getV((c.opcode & 0x00F0) >> 4)
 }
 
 func (c *Chip8) setVF(b byte) {
-	c.register[0xF] = b
+	c.setV(0xF, b)
 }
 
 func (c *Chip8) getNNN() uint16 {
@@ -381,21 +819,110 @@ func (c *Chip8) getNN() byte {
 	return byte(c.opcode & 0x00FF)
 }
 
+//getV reads a register, tripping any watchpoints on it
+func (c *Chip8) getV(r uint16) byte {
+	if c.watches != nil {
+		c.watch(true, r, 1, false)
+	}
+	return c.register[r]
+}
+
+//setV writes a register, tripping any watchpoints on it
+func (c *Chip8) setV(r uint16, b byte) {
+	if c.watches != nil {
+		c.watch(true, r, 1, true)
+	}
+	c.register[r] = b
+}
+
+//load reads a byte of memory, tripping any watchpoints on it
+func (c *Chip8) load(addr uint16) byte {
+	if c.watches != nil {
+		c.watch(false, addr, 1, false)
+	}
+	return c.mem[addr]
+}
+
+//loads reads n bytes of memory, tripping any watchpoints on them
+func (c *Chip8) loads(addr uint16, n int) []byte {
+	if c.watches != nil {
+		c.watch(false, addr, n, false)
+	}
+	return c.read(addr, n)
+}
+
+//store writes a byte of memory, tripping any watchpoints on it
+func (c *Chip8) store(addr uint16, b byte) {
+	if c.watches != nil {
+		c.watch(false, addr, 1, true)
+	}
+	c.mem[addr] = b
+}
+
+//skip the next instruction, which is 4 bytes long if it is F000 NNNN
+func (c *Chip8) skip() {
+	c.pc += 2
+	if c.mem[c.pc] == 0xF0 && c.mem[c.pc+1] == 0x00 {
+		c.pc += 2
+	}
+	c.pc += 2
+}
+
+//span lists the registers from X to Y, in either order
+func (c *Chip8) span() []uint16 {
+	x, y := (c.opcode&0x0F00)>>8, (c.opcode&0x00F0)>>4
+	var rs []uint16
+	for {
+		rs = append(rs, x)
+		if x == y {
+			return rs
+		}
+		if x < y {
+			x++
+		} else {
+			x--
+		}
+	}
+}
+
+//advanceIndex moves I past the X+1 registers stored or loaded, as the memory quirks say
+func (c *Chip8) advanceIndex(x uint16) {
+	switch {
+	case c.quirks.MemoryLeaveIUnchanged:
+	case c.quirks.MemoryIncrementByX:
+		c.index += x
+	default:
+		c.index += x + 1
+	}
+}
+
+//planes counts the selected drawing planes
+func (c *Chip8) planes() int {
+	n := 0
+	for p := c.plane; p != 0; p >>= 1 {
+		n += int(p & 1)
+	}
+	return n
+}
+
+//read n bytes of memory from addr, wrapping at the end of the address space
+func (c *Chip8) read(addr uint16, n int) []byte {
+	if int(addr)+n <= len(c.mem) {
+		return c.mem[addr : int(addr)+n]
+	}
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = c.mem[addr+uint16(i)]
+	}
+	return b
+}
+
 func (c *Chip8) fetch() {
 	c.opcode = uint16(c.mem[c.pc])<<8 | uint16(c.mem[c.pc+1])
 }
 
 func (c *Chip8) decode() {
-	switch c.opcode & 0xF000 {
-	case 0x0000:
-		c.codeKey = c.opcode
-	case 0x8000:
-		c.codeKey = c.opcode & 0xF00F
-	case 0xE000, 0xF000:
-		c.codeKey = c.opcode & 0xF0FF
-	default:
-		c.codeKey = c.opcode & 0xF000
-	}
+	c.codeKey = Decode(c.opcode)
 }
 
 func (c *Chip8) exec() error {
@@ -403,18 +930,68 @@ func (c *Chip8) exec() error {
 	if !ok {
 		return fmt.Errorf("unknown opcode %X", c.opcode)
 	}
-	f(c)
-	return nil
+	f.exec(c)
+	err := c.fault
+	c.fault = nil
+	return err
 }
 
-func (c *Chip8) countDown() {
-	for range c.timerTick {
-		if c.delayTimer > 0 {
-			c.delayTimer--
-		}
-		if c.soundTimer > 0 {
-			c.Beep()
-			c.soundTimer--
+//Decode returns the key of the instruction table entry an opcode executes
+func Decode(opcode uint16) uint16 {
+	switch opcode & 0xF000 {
+	case 0x0000:
+		switch opcode & 0xFFF0 {
+		case 0x00C0, 0x00D0:
+			return opcode & 0xFFF0
+		default:
+			return opcode
 		}
+	case 0x5000, 0x8000:
+		return opcode & 0xF00F
+	case 0xE000, 0xF000:
+		return opcode & 0xF0FF
+	default:
+		return opcode & 0xF000
+	}
+}
+
+//Mnemonic returns the assembly template of the instruction an opcode executes
+func Mnemonic(opcode uint16) (string, bool) {
+	f, ok := funcmap[Decode(opcode)]
+	return f.asm, ok
+}
+
+//Mnemonics lists the assembly templates of the instruction table by key
+func Mnemonics() map[uint16]string {
+	m := make(map[uint16]string, len(funcmap))
+	for k, f := range funcmap {
+		m[k] = f.asm
 	}
+	return m
+}
+
+//tick counts the timers down for one frame
+func (c *Chip8) tick() {
+
+	if c.delayTimer > 0 {
+		c.delayTimer--
+	}
+	if c.soundTimer > 0 {
+		c.Beep()
+		c.soundTimer--
+	}
+}
+
+//present shows the display if it changed since it was last shown
+func (c *Chip8) present() {
+
+	if c.display.dirty {
+		c.display.dirty = false
+		c.Present(&c.display)
+	}
+}
+
+//Display returns a copy of the display
+func (c *Chip8) Display() Display {
+	return c.display
 }
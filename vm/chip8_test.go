@@ -0,0 +1,33 @@
+package vm
+
+import (
+	"bytes"
+	"testing"
+)
+
+//run loads rom into a machine without quirks and executes cycles instructions of it
+func run(t *testing.T, rom []byte, cycles int) *Chip8 {
+
+	c := new(Chip8)
+	c.Init(nothing{}, nothing{}, nothing{}, nil, Quirks{})
+	if err := c.Load(bytes.NewReader(rom)); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Run(cycles); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestAddIKeepsVF(t *testing.T) {
+
+	c := run(t, []byte{
+		0xF0, 0x00, 0x10, 0x00, //LD I, long 1000
+		0x6F, 0x07, //LD VF, 7
+		0x61, 0x02, //LD V1, 2
+		0xF1, 0x1E, //ADD I, V1
+	}, 4)
+	if c.index != 0x1002 || c.register[0xF] != 7 {
+		t.Errorf("I is %04X and VF %d, want 1002 and 7", c.index, c.register[0xF])
+	}
+}
@@ -0,0 +1,142 @@
+package vm
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	//Clock tells the time and paces Loop with its tickers.
+	//WallClock runs in real time, a VirtualClock only when it is advanced.
+	Clock interface {
+		Now() time.Time
+		NewTicker(d time.Duration) Ticker
+	}
+
+	//Ticker sends the time on C every period, until it is stopped
+	Ticker interface {
+		C() <-chan time.Time
+		Stop()
+	}
+
+	wallClock struct{}
+
+	wallTicker struct {
+		*time.Ticker
+	}
+
+	//VirtualClock is a Clock whose time only moves when it is advanced, for deterministic runs.
+	//The zero value starts at the zero time.
+	VirtualClock struct {
+		mu      sync.Mutex
+		now     time.Time
+		tickers []*virtualTicker
+	}
+
+	virtualTicker struct {
+		c      chan time.Time
+		period time.Duration
+		next   time.Time
+		stop   chan struct{}
+		once   sync.Once
+	}
+)
+
+//WallClock is the real time clock
+var WallClock Clock = wallClock{}
+
+func (wallClock) Now() time.Time {
+	return time.Now()
+}
+
+func (wallClock) NewTicker(d time.Duration) Ticker {
+	return wallTicker{time.NewTicker(d)}
+}
+
+func (t wallTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+//NewVirtualClock makes a VirtualClock starting at now
+func NewVirtualClock(now time.Time) *VirtualClock {
+	return &VirtualClock{now: now}
+}
+
+//Now Impl
+func (v *VirtualClock) Now() time.Time {
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.now
+}
+
+//NewTicker Impl
+func (v *VirtualClock) NewTicker(d time.Duration) Ticker {
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	t := &virtualTicker{
+		c:      make(chan time.Time),
+		period: d,
+		next:   v.now.Add(d),
+		stop:   make(chan struct{}),
+	}
+	v.tickers = append(v.tickers, t)
+	return t
+}
+
+//Advance moves the time on by d, firing the ticks due in order.
+//Every tick is sent unbuffered, so none is dropped: Advance returns once
+//the last one was received, or its ticker stopped.
+func (v *VirtualClock) Advance(d time.Duration) {
+
+	v.mu.Lock()
+	end := v.now.Add(d)
+	for {
+		var due *virtualTicker
+		running := v.tickers[:0]
+		for _, t := range v.tickers {
+			if t.stopped() {
+				continue
+			}
+			running = append(running, t)
+			if !t.next.After(end) && (due == nil || t.next.Before(due.next)) {
+				due = t
+			}
+		}
+		v.tickers = running
+		if due == nil {
+			break
+		}
+		now := due.next
+		v.now = now
+		due.next = now.Add(due.period)
+		v.mu.Unlock()
+		select {
+		case due.c <- now:
+		case <-due.stop:
+		}
+		v.mu.Lock()
+	}
+	v.now = end
+	v.mu.Unlock()
+}
+
+func (t *virtualTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *virtualTicker) Stop() {
+	t.once.Do(func() {
+		close(t.stop)
+	})
+}
+
+func (t *virtualTicker) stopped() bool {
+	select {
+	case <-t.stop:
+		return true
+	default:
+		return false
+	}
+}
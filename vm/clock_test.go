@@ -0,0 +1,124 @@
+package vm
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+//nothing is a screen, speaker and keyboard for machines no one watches
+type nothing struct{}
+
+func (nothing) Present(*Display)    {}
+func (nothing) Beep()               {}
+func (nothing) IsPressed(byte) bool { return false }
+
+//counter counts V0 up and draws random numbers into V1, 3 instructions a loop
+var counter = []byte{
+	0x70, 0x01, //ADD V0, 1
+	0xC1, 0xFF, //RND V1, FF
+	0x12, 0x00, //JP 200
+}
+
+//loopFrames runs counter in Loop for frames ticks of a VirtualClock
+//and returns V0 and V1 as they are then
+func loopFrames(t *testing.T, frames int) (v0, v1 byte) {
+
+	quit := make(chan struct{})
+	c := new(Chip8)
+	c.Init(nothing{}, nothing{}, nothing{}, quit, Quirks{})
+	clock := NewVirtualClock(time.Unix(0, 0))
+	c.SetClock(clock)
+	if err := c.Load(bytes.NewReader(counter)); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error)
+	go func() {
+		errs <- c.Loop()
+	}()
+	for i := 0; i < frames; i++ {
+		clock.Advance(timerDuration)
+	}
+	read := make(chan struct{})
+	c.Do(func() {
+		v0, v1 = c.register[0], c.register[1]
+		close(read)
+	})
+	<-read
+	close(quit)
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	return v0, v1
+}
+
+func TestVirtualClockLoop(t *testing.T) {
+
+	const frames = 60
+	v0, v1 := loopFrames(t, frames)
+	//every frame runs defaultTickRate cycles, the first of every 3 adding 1 to V0
+	if want := byte((frames*defaultTickRate + 2) / 3); v0 != want {
+		t.Errorf("V0 is %d after %d frames, want %d", v0, frames, want)
+	}
+	if v0again, v1again := loopFrames(t, frames); v0again != v0 || v1again != v1 {
+		t.Errorf("second run ended with V0 %d V1 %d, first with V0 %d V1 %d", v0again, v1again, v0, v1)
+	}
+}
+
+//presents counts the displays presented
+type presents struct {
+	nothing
+	n int
+}
+
+func (p *presents) Present(*Display) {
+	p.n++
+}
+
+func TestUnthrottledLoop(t *testing.T) {
+
+	quit := make(chan struct{})
+	p := new(presents)
+	c := new(Chip8)
+	c.Init(p, nothing{}, nothing{}, quit, Quirks{})
+	clock := NewVirtualClock(time.Unix(0, 0))
+	c.SetClock(clock)
+	c.SetSpeed(Unthrottled)
+	//clearing the display every loop changes it for every tick to present
+	if err := c.Load(bytes.NewReader([]byte{0x00, 0xE0, 0x12, 0x00})); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error)
+	go func() {
+		errs <- c.Loop()
+	}()
+	advanced := make(chan struct{})
+	go func() {
+		for i := 0; i < 60; i++ {
+			clock.Advance(timerDuration)
+		}
+		close(advanced)
+	}()
+	select {
+	case <-advanced:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Loop stopped taking the ticks of the clock")
+	}
+
+	var n int
+	read := make(chan struct{})
+	c.Do(func() {
+		n = p.n
+		close(read)
+	})
+	<-read
+	close(quit)
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 {
+		t.Error("no display presented in 60 ticks")
+	}
+}
@@ -0,0 +1,255 @@
+package vm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+//cond is a compiled debugger condition, true when it evaluates to non-zero.
+//
+//Conditions are C-like expressions over integers:
+//	V0-VF I PC SP DT ST   the registers
+//	[expr]                the memory byte at an address
+//	12 0x1F 0b101         numbers
+//	( ) ! ~ - * & << >> + | ^ == != < <= > >= && ||
+//
+//For example: V3 == 0x10 && I > 0x300
+type cond func(c *Chip8) int
+
+//binaryOps lists the binary operators from the loosest to the tightest binding
+var binaryOps = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<=", ">=", "<", ">"},
+	{"+", "-", "|", "^"},
+	{"*", "&", "<<", ">>"},
+}
+
+//pairOps are the operators spelled with two characters
+var pairOps = []string{"==", "!=", "<=", ">=", "&&", "||", "<<", ">>"}
+
+type condParser struct {
+	toks []string
+	pos  int
+}
+
+//parseCond compiles a condition
+func parseCond(s string) (cond, error) {
+
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &condParser{toks: toks}
+
+	e, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.toks) {
+		return nil, fmt.Errorf("unexpected %q", p.toks[p.pos])
+	}
+	return e, nil
+}
+
+func tokenize(s string) ([]string, error) {
+
+	var toks []string
+	for i := 0; i < len(s); {
+		r := rune(s[i])
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			j := i
+			for j < len(s) && (unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		case i+1 < len(s) && contains(pairOps, s[i:i+2]):
+			toks = append(toks, s[i:i+2])
+			i += 2
+		case strings.ContainsRune("()[]!~-*&+|^<>", r):
+			toks = append(toks, s[i:i+1])
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected %q", r)
+		}
+	}
+	return toks, nil
+}
+
+func (p *condParser) peek() string {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return ""
+}
+
+func (p *condParser) binary(level int) (cond, error) {
+
+	if level == len(binaryOps) {
+		return p.unary()
+	}
+
+	l, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if !contains(binaryOps[level], op) {
+			return l, nil
+		}
+		p.pos++
+		r, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		l = combine(op, l, r)
+	}
+}
+
+func (p *condParser) unary() (cond, error) {
+
+	op := p.peek()
+	switch op {
+	case "!", "~", "-":
+		p.pos++
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case "!":
+			return func(c *Chip8) int { return truth(e(c) == 0) }, nil
+		case "~":
+			return func(c *Chip8) int { return ^e(c) }, nil
+		default:
+			return func(c *Chip8) int { return -e(c) }, nil
+		}
+	}
+	return p.primary()
+}
+
+func (p *condParser) primary() (cond, error) {
+
+	tok := p.peek()
+	if tok == "" {
+		return nil, fmt.Errorf("unexpected end of condition")
+	}
+	p.pos++
+
+	switch tok {
+	case "(", "[":
+		e, err := p.binary(0)
+		if err != nil {
+			return nil, err
+		}
+		closing := map[string]string{"(": ")", "[": "]"}[tok]
+		if p.peek() != closing {
+			return nil, fmt.Errorf("missing %q", closing)
+		}
+		p.pos++
+		if tok == "[" {
+			return func(c *Chip8) int { return int(c.mem[uint16(e(c))]) }, nil
+		}
+		return e, nil
+	}
+
+	if r, ok := register(tok); ok {
+		return func(c *Chip8) int { return int(c.register[r]) }, nil
+	}
+	switch strings.ToUpper(tok) {
+	case "I":
+		return func(c *Chip8) int { return int(c.index) }, nil
+	case "PC":
+		return func(c *Chip8) int { return int(c.pc) }, nil
+	case "SP":
+		return func(c *Chip8) int { return int(c.sp) }, nil
+	case "DT":
+		return func(c *Chip8) int { return int(c.delayTimer) }, nil
+	case "ST":
+		return func(c *Chip8) int { return int(c.soundTimer) }, nil
+	}
+
+	n, err := parseNumber(tok)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected %q", tok)
+	}
+	return func(*Chip8) int { return n }, nil
+}
+
+func combine(op string, l, r cond) cond {
+
+	switch op {
+	case "||":
+		return func(c *Chip8) int { return truth(l(c) != 0 || r(c) != 0) }
+	case "&&":
+		return func(c *Chip8) int { return truth(l(c) != 0 && r(c) != 0) }
+	case "==":
+		return func(c *Chip8) int { return truth(l(c) == r(c)) }
+	case "!=":
+		return func(c *Chip8) int { return truth(l(c) != r(c)) }
+	case "<=":
+		return func(c *Chip8) int { return truth(l(c) <= r(c)) }
+	case ">=":
+		return func(c *Chip8) int { return truth(l(c) >= r(c)) }
+	case "<":
+		return func(c *Chip8) int { return truth(l(c) < r(c)) }
+	case ">":
+		return func(c *Chip8) int { return truth(l(c) > r(c)) }
+	case "+":
+		return func(c *Chip8) int { return l(c) + r(c) }
+	case "-":
+		return func(c *Chip8) int { return l(c) - r(c) }
+	case "|":
+		return func(c *Chip8) int { return l(c) | r(c) }
+	case "^":
+		return func(c *Chip8) int { return l(c) ^ r(c) }
+	case "*":
+		return func(c *Chip8) int { return l(c) * r(c) }
+	case "&":
+		return func(c *Chip8) int { return l(c) & r(c) }
+	case "<<":
+		return func(c *Chip8) int { return l(c) << uint(r(c)&31) }
+	default:
+		return func(c *Chip8) int { return l(c) >> uint(r(c)&31) }
+	}
+}
+
+//register parses V0-VF
+func register(tok string) (int, bool) {
+
+	if len(tok) != 2 || (tok[0] != 'V' && tok[0] != 'v') {
+		return 0, false
+	}
+	r, err := strconv.ParseUint(tok[1:], 16, 8)
+	return int(r), err == nil
+}
+
+//parseNumber parses decimal, 0x hex and 0b binary numbers
+func parseNumber(tok string) (int, error) {
+
+	n, err := strconv.ParseInt(tok, 0, 32)
+	return int(n), err
+}
+
+func truth(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
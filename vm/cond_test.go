@@ -0,0 +1,53 @@
+package vm
+
+import "testing"
+
+func TestParseCond(t *testing.T) {
+
+	c := new(Chip8)
+	c.Init(nothing{}, nothing{}, nothing{}, nil, Quirks{})
+	c.register[3] = 0x10
+	c.register[0xA] = 2
+	c.index = 0x310
+	c.pc = 0x246
+	c.delayTimer = 9
+	c.mem[0x300] = 0x42
+	c.mem[0x301] = 0x07
+
+	for _, tc := range []struct {
+		cond string
+		want int
+	}{
+		{"V3 == 0x10 && I > 0x300", 1},
+		{"V3 == 0x10 && I < 0x300", 0},
+		{"V3 != 0x10 || I > 0x300", 1},
+		{"v3 == 16", 1},
+		{"va + 1 == 3", 1},
+		{"VA << 2 + 1", 9},
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"1 | 2 == 3", 1},
+		{"0 && 1 || 1", 1},
+		{"!0 + ~0 + -1", -1},
+		{"[0x300]", 0x42},
+		{"[0x300 + 1] == 7", 1},
+		{"[I - 0x10] == 0x42", 1},
+		{"PC == 0x246 && DT == 9 && SP == 0 && ST == 0", 1},
+		{"0b101 ^ 0x3", 6},
+	} {
+		cd, err := parseCond(tc.cond)
+		if err != nil {
+			t.Errorf("%s: %v", tc.cond, err)
+			continue
+		}
+		if got := cd(c); got != tc.want {
+			t.Errorf("%s = %d, want %d", tc.cond, got, tc.want)
+		}
+	}
+
+	for _, s := range []string{"", "V3 ==", "(V3", "[0x300", "V3 = 1", "V3 == 1)", "VG", "1 2", "V3 == 'a'"} {
+		if _, err := parseCond(s); err == nil {
+			t.Errorf("%q parsed without an error", s)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//debugCode is how many bytes from PC a Debug view carries
+const debugCode = 8
+
+type (
+	//condition is a breakpoint on a condition alone, checked before every instruction
+	condition struct {
+		desc string
+		cond cond
+	}
+
+	//watchpoint trips when any of the registers or memory addresses from lo to hi is accessed
+	watchpoint struct {
+		desc   string
+		reg    bool
+		lo, hi uint16
+		read   bool
+		write  bool
+		cond   cond
+	}
+
+	//SourceLine is a line of the source a program was built from
+	SourceLine struct {
+		File string
+		Line int
+		Text string
+	}
+
+	//SourceMap gives the source line of each instruction address
+	SourceMap map[uint16]SourceLine
+)
+
+//Debug is a view of the machine for the step debugger
+type Debug struct {
+	V           [16]byte
+	I           uint16
+	PC          uint16
+	SP          uint16
+	Stack       [16]uint16
+	DT          byte
+	ST          byte
+	Code        []byte
+	Breakpoints []uint16
+	//Source is the source line of PC, with an empty File when unknown
+	Source SourceLine
+	//Watches describes the watchpoints and conditional breakpoints
+	Watches []string
+	//Reason says why the machine last stopped
+	Reason string
+	Paused bool
+	//Speed is the speed set with SetSpeed
+	Speed float64
+	//TickRate is the number of cycles per 60Hz frame
+	TickRate int
+}
+
+//OnDebug sets the function told about the machine whenever it pauses, steps or resumes
+func (c *Chip8) OnDebug(f func(Debug)) {
+	c.debug = f
+}
+
+//SetSource sets the source map of the program, for the debugger to show the line at PC
+func (c *Chip8) SetSource(m SourceMap) {
+	c.source = m
+}
+
+//Pause stops executing instructions
+func (c *Chip8) Pause() {
+	c.Do(func() {
+		c.paused = true
+		c.report()
+	})
+}
+
+//Resume continues executing instructions
+func (c *Chip8) Resume() {
+	c.Do(func() {
+		c.paused = false
+		c.resumed = true
+		c.reason = ""
+		c.report()
+	})
+}
+
+//Step runs a single cycle while paused
+func (c *Chip8) Step() {
+	c.Do(func() {
+		if !c.paused {
+			return
+		}
+		c.reason = ""
+		c.err = c.advance(1, false)
+		c.watched()
+		c.present()
+		c.report()
+	})
+}
+
+//SetBreakpoint sets or clears a breakpoint on a PC address
+func (c *Chip8) SetBreakpoint(addr uint16, on bool) {
+	c.Do(func() {
+		if on {
+			c.breakpoints[addr] = nil
+		} else {
+			delete(c.breakpoints, addr)
+		}
+		c.report()
+	})
+}
+
+//ToggleBreakpoint sets or clears a breakpoint on the current PC
+func (c *Chip8) ToggleBreakpoint() {
+	c.Do(func() {
+		if _, ok := c.breakpoints[c.pc]; ok {
+			delete(c.breakpoints, c.pc)
+		} else {
+			c.breakpoints[c.pc] = nil
+		}
+		c.report()
+	})
+}
+
+//debugView copies the machine state into a Debug
+func (c *Chip8) debugView() Debug {
+
+	d := Debug{
+		V:        c.register,
+		I:        c.index,
+		PC:       c.pc,
+		SP:       c.sp,
+		Stack:    c.stack,
+		DT:       c.delayTimer,
+		ST:       c.soundTimer,
+		Code:     append([]byte(nil), c.read(c.pc, debugCode)...),
+		Source:   c.source[c.pc],
+		Reason:   c.reason,
+		Paused:   c.paused,
+		Speed:    c.speed,
+		TickRate: c.perFrame,
+	}
+	for _, cd := range c.conds {
+		d.Watches = append(d.Watches, cd.desc)
+	}
+	for _, w := range c.watches {
+		d.Watches = append(d.Watches, w.desc)
+	}
+	for a := range c.breakpoints {
+		d.Breakpoints = append(d.Breakpoints, a)
+	}
+	sort.Slice(d.Breakpoints, func(i, j int) bool {
+		return d.Breakpoints[i] < d.Breakpoints[j]
+	})
+	return d
+}
+
+func (c *Chip8) report() {
+	if c.debug != nil {
+		c.debug(c.debugView())
+	}
+}
+
+//breaks reports whether execution should stop before the instruction at PC
+func (c *Chip8) breaks() bool {
+
+	if c.resumed {
+		c.resumed = false
+		return false
+	}
+
+	if cd, ok := c.breakpoints[c.pc]; ok && (cd == nil || cd(c) != 0) {
+		c.reason = fmt.Sprintf("breakpoint %04X", c.pc)
+		return true
+	}
+	for _, cd := range c.conds {
+		if cd.cond(c) != 0 {
+			c.reason = cd.desc
+			return true
+		}
+	}
+	return false
+}
+
+//watch records the watchpoints tripped by an access
+func (c *Chip8) watch(reg bool, addr uint16, n int, write bool) {
+
+	for _, w := range c.watches {
+		if w.reg != reg || (write && !w.write) || (!write && !w.read) {
+			continue
+		}
+		if int(addr) <= int(w.hi) && int(addr)+n-1 >= int(w.lo) {
+			c.hits = append(c.hits, w)
+		}
+	}
+}
+
+//watched reports whether a watchpoint tripped by the last instruction should stop execution.
+//Conditions are checked after the instruction so they see the values it wrote.
+func (c *Chip8) watched() bool {
+
+	hits := c.hits
+	c.hits = nil
+	for _, w := range hits {
+		if w.cond == nil || w.cond(c) != 0 {
+			c.reason = fmt.Sprintf("%s by %04X", w.desc, c.inst)
+			return true
+		}
+	}
+	return false
+}
+
+//Command runs a debugger command line:
+//	b ADDR [if COND]        break at a PC address, optionally only when COND holds
+//	b if COND               break as soon as COND holds
+//	r|w|a TARGET [if COND]  break on a read, write or any access of TARGET,
+//	                        which is a register V0-VF, an address or a range like 0x300-0x30F
+//	d [ADDR]                delete the breakpoint at ADDR, or every breakpoint and watchpoint
+//See cond for the syntax of conditions.
+func (c *Chip8) Command(line string) error {
+
+	line = strings.TrimSpace(line)
+	var expr string
+	if i := strings.Index(line, " if "); i >= 0 {
+		line, expr = line[:i], strings.TrimSpace(line[i+4:])
+	}
+
+	var cd cond
+	if expr != "" {
+		var err error
+		if cd, err = parseCond(expr); err != nil {
+			return err
+		}
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return errors.New("empty command")
+	}
+
+	//f applies the command on the emulation goroutine
+	var f func()
+	switch fields[0] {
+	case "b":
+		if len(fields) == 1 {
+			if cd == nil {
+				return errors.New("b needs an address or a condition")
+			}
+			f = func() {
+				c.conds = append(c.conds, condition{"if " + expr, cd})
+			}
+			break
+		}
+		addr, err := parseAddr(fields[1])
+		if err != nil {
+			return err
+		}
+		f = func() {
+			c.breakpoints[addr] = cd
+		}
+	case "r", "w", "a":
+		if len(fields) != 2 {
+			return fmt.Errorf("%s needs one target", fields[0])
+		}
+		w, err := parseWatch(fields[1])
+		if err != nil {
+			return err
+		}
+		w.read = fields[0] != "w"
+		w.write = fields[0] != "r"
+		w.cond = cd
+		w.desc = map[string]string{"r": "read ", "w": "write ", "a": "access "}[fields[0]] + fields[1]
+		if expr != "" {
+			w.desc += " if " + expr
+		}
+		f = func() {
+			c.watches = append(c.watches, w)
+		}
+	case "d":
+		if len(fields) == 1 {
+			f = func() {
+				c.breakpoints = make(map[uint16]cond)
+				c.conds = nil
+				c.watches = nil
+			}
+			break
+		}
+		addr, err := parseAddr(fields[1])
+		if err != nil {
+			return err
+		}
+		f = func() {
+			delete(c.breakpoints, addr)
+		}
+	default:
+		return fmt.Errorf("unknown command %q", fields[0])
+	}
+
+	if !c.Do(func() {
+		f()
+		c.report()
+	}) {
+		return errStopped
+	}
+	return nil
+}
+
+//parseWatch parses a watchpoint target
+func parseWatch(target string) (watchpoint, error) {
+
+	if r, ok := register(target); ok {
+		return watchpoint{reg: true, lo: uint16(r), hi: uint16(r)}, nil
+	}
+
+	lo, hi := target, target
+	if i := strings.Index(target, "-"); i >= 0 {
+		lo, hi = target[:i], target[i+1:]
+	}
+	l, err := parseAddr(lo)
+	if err != nil {
+		return watchpoint{}, err
+	}
+	h, err := parseAddr(hi)
+	if err != nil {
+		return watchpoint{}, err
+	}
+	if h < l {
+		l, h = h, l
+	}
+	return watchpoint{lo: l, hi: h}, nil
+}
+
+//parseAddr parses an address, which is hex with or without the 0x prefix
+func parseAddr(s string) (uint16, error) {
+
+	a, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(s), "0x"), 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("bad address %q", s)
+	}
+	return uint16(a), nil
+}
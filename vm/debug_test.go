@@ -0,0 +1,60 @@
+package vm
+
+import (
+	"bytes"
+	"testing"
+)
+
+//watchRom stores V0-V2 at 0x2FE-0x300 with FX55, then loops
+var watchRom = []byte{
+	0xA2, 0xFE, //200: LD I, 2FE
+	0x60, 0x05, //202: LD V0, 5
+	0xF2, 0x55, //204: LD [I], V2
+	0x12, 0x06, //206: JP 206
+}
+
+func TestWatchpoints(t *testing.T) {
+
+	for _, tc := range []struct {
+		cmd    string
+		paused bool
+		pc     uint16
+		reason string
+	}{
+		{"w 0x300", true, 0x206, "write 0x300 by 0204"},
+		{"a 300", true, 0x206, "access 300 by 0204"},
+		{"w 0x2F0-0x2FE", true, 0x206, "write 0x2F0-0x2FE by 0204"},
+		{"w 0x301-0x30F", false, 0x206, ""},
+		{"r 0x300", false, 0x206, ""},
+		{"w V0", true, 0x204, "write V0 by 0202"},
+		{"w 0x300 if [0x300] == 0", true, 0x206, "write 0x300 if [0x300] == 0 by 0204"},
+		{"w 0x300 if V0 == 6", false, 0x206, ""},
+	} {
+		c := new(Chip8)
+		c.Init(nothing{}, nothing{}, nothing{}, nil, Quirks{MemoryLeaveIUnchanged: true})
+		if err := c.Load(bytes.NewReader(watchRom)); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Command(tc.cmd); err != nil {
+			t.Fatalf("%s: %v", tc.cmd, err)
+		}
+		if err := c.advance(10, true); err != nil {
+			t.Fatalf("%s: %v", tc.cmd, err)
+		}
+		if c.paused != tc.paused || c.pc != tc.pc || c.reason != tc.reason {
+			t.Errorf("%s: paused %v at %04X for %q, want paused %v at %04X for %q",
+				tc.cmd, c.paused, c.pc, c.reason, tc.paused, tc.pc, tc.reason)
+		}
+	}
+}
+
+func TestCommandErrors(t *testing.T) {
+
+	c := new(Chip8)
+	c.Init(nothing{}, nothing{}, nothing{}, nil, Quirks{})
+	for _, cmd := range []string{"", "x 200", "b", "b zz", "w", "w 0x300 0x301", "r V0-V3", "b 200 if V0 ==", "d nowhere"} {
+		if err := c.Command(cmd); err == nil {
+			t.Errorf("%q ran without an error", cmd)
+		}
+	}
+}
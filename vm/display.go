@@ -0,0 +1,201 @@
+package vm
+
+import "errors"
+
+//Display is the framebuffer of the machine: two planes of 128x64 pixels,
+//of which low resolution uses the top left 64x32.
+//The zero value is a clear low resolution display.
+type Display struct {
+	gfx   [2][128][64]bool
+	hires bool
+	//dirty is set by every change, until the display is presented
+	dirty bool
+}
+
+//Size is the resolution of the display, 64x32 or 128x64
+func (d *Display) Size() (w, h int) {
+	if d.hires {
+		return 128, 64
+	}
+	return 64, 32
+}
+
+//Pixel is the planes pixel x, y is set in, 0 for none to 3 for both (XO-CHIP)
+func (d *Display) Pixel(x, y int) int {
+
+	var c int
+	for p := range d.gfx {
+		if d.gfx[p][x][y] {
+			c |= 1 << uint(p)
+		}
+	}
+	return c
+}
+
+//clear the planes of mask
+func (d *Display) clear(mask byte) {
+
+	w, h := d.Size()
+	for p := range d.gfx {
+		if mask&(1<<uint(p)) == 0 {
+			continue
+		}
+		for i := 0; i < w; i++ {
+			for j := 0; j < h; j++ {
+				d.gfx[p][i][j] = false
+			}
+		}
+	}
+	d.dirty = true
+}
+
+//setHires switches between 64x32 and 128x64, clearing the display
+func (d *Display) setHires(on bool) {
+	d.hires = on
+	d.gfx = [2][128][64]bool{}
+	d.dirty = true
+}
+
+//scroll the planes of mask by dx, dy pixels, what comes in being clear
+func (d *Display) scroll(mask byte, dx, dy int) {
+
+	w, h := d.Size()
+	for p := range d.gfx {
+		if mask&(1<<uint(p)) == 0 {
+			continue
+		}
+		var gfx [128][64]bool
+		for i := 0; i < w; i++ {
+			for j := 0; j < h; j++ {
+				si, sj := i-dx, j-dy
+				if si >= 0 && si < w && sj >= 0 && sj < h {
+					gfx[i][j] = d.gfx[p][si][sj]
+				}
+			}
+		}
+		d.gfx[p] = gfx
+	}
+	d.dirty = true
+}
+
+//draw a sprite of 8 pixel rows, XORing it onto the display and returning 1 if a pixel was erased
+func (d *Display) draw(mask byte, wrap bool, x, y int, mem []byte) byte {
+
+	rows := make([]uint16, len(mem))
+	for j, m := range mem {
+		rows[j] = uint16(m) << 8
+	}
+	return d.xor(mask, wrap, x, y, rows)
+}
+
+//drawWide draws a sprite of 16 pixel rows, two bytes each (SCHIP)
+func (d *Display) drawWide(mask byte, wrap bool, x, y int, mem []byte) byte {
+
+	rows := make([]uint16, len(mem)/2)
+	for j := range rows {
+		rows[j] = uint16(mem[2*j])<<8 | uint16(mem[2*j+1])
+	}
+	return d.xor(mask, wrap, x, y, rows)
+}
+
+//xor draws one sprite per plane of mask, each taking an equal share of rows.
+//Sprites are clipped at the edges of the display, or wrap around them if wrap is set.
+func (d *Display) xor(mask byte, wrap bool, x, y int, rows []uint16) byte {
+
+	var flag byte
+	var xi, yj int
+	w, h := d.Size()
+	x, y = x%w, y%h
+
+	n := 0
+	for p := range d.gfx {
+		if mask&(1<<uint(p)) != 0 {
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	share := len(rows) / n
+
+	for p := range d.gfx {
+		if mask&(1<<uint(p)) == 0 {
+			continue
+		}
+		for j, m := range rows[:share] {
+			yj = y + j
+			if yj >= h {
+				if !wrap {
+					break
+				}
+				yj %= h
+			}
+			for i := 0; i < 16; i++ {
+				if m&(0x8000>>uint(i)) != 0 {
+					xi = x + i
+					if xi >= w {
+						if !wrap {
+							break
+						}
+						xi %= w
+					}
+					if d.gfx[p][xi][yj] {
+						d.gfx[p][xi][yj] = false
+						flag = 1
+					} else {
+						d.gfx[p][xi][yj] = true
+					}
+				}
+			}
+		}
+		rows = rows[share:]
+	}
+	d.dirty = true
+	return flag
+}
+
+//snapshot packs the display into bytes: 1 for high resolution or 0,
+//then each plane as 64 rows of 128 bits
+func (d *Display) snapshot() []byte {
+
+	b := make([]byte, 1, 1+len(d.gfx)*128*64/8)
+	if d.hires {
+		b[0] = 1
+	}
+	for p := range d.gfx {
+		for j := 0; j < 64; j++ {
+			for i := 0; i < 128; i += 8 {
+				var m byte
+				for k := 0; k < 8; k++ {
+					if d.gfx[p][i+k][j] {
+						m |= 0x80 >> uint(k)
+					}
+				}
+				b = append(b, m)
+			}
+		}
+	}
+	return b
+}
+
+//restore unpacks a display packed by snapshot
+func (d *Display) restore(b []byte) error {
+
+	if len(b) != 1+len(d.gfx)*128*64/8 {
+		return errors.New("bad framebuffer snapshot")
+	}
+	d.setHires(b[0] == 1)
+
+	b = b[1:]
+	for p := range d.gfx {
+		for j := 0; j < 64; j++ {
+			for i := 0; i < 128; i += 8 {
+				for k := 0; k < 8; k++ {
+					d.gfx[p][i+k][j] = b[0]&(0x80>>uint(k)) != 0
+				}
+				b = b[1:]
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package vm
+
+//Quirks select between the behaviours of ambiguous opcodes that differ across interpreters.
+//The field names follow the quirks of the community chip-8-database.
+type Quirks struct {
+	//Shift makes 8XY6/8XYE shift VX in place instead of shifting VY into VX
+	Shift bool
+	//MemoryIncrementByX makes FX55/FX65 increment I by X instead of X+1
+	MemoryIncrementByX bool
+	//MemoryLeaveIUnchanged makes FX55/FX65 leave I unchanged
+	MemoryLeaveIUnchanged bool
+	//Wrap makes sprites wrap around the display edges instead of being clipped
+	Wrap bool
+	//Jump makes BXNN jump to XNN plus VX instead of NNN plus V0
+	Jump bool
+	//VBlank makes the machine idle after DXYN until the next 60Hz frame
+	VBlank bool
+	//Logic makes 8XY1/8XY2/8XY3 reset VF to 0
+	Logic bool
+}
+
+var (
+	//QuirksVIP is the original COSMAC VIP interpreter
+	QuirksVIP = Quirks{
+		VBlank: true,
+		Logic:  true,
+	}
+
+	//QuirksCHIP48 is the CHIP-48 interpreter for the HP-48 calculators
+	QuirksCHIP48 = Quirks{
+		Shift:              true,
+		MemoryIncrementByX: true,
+		Jump:               true,
+	}
+
+	//QuirksSCHIP1 is the SUPER-CHIP 1.0 interpreter, which still increments I like CHIP-48
+	QuirksSCHIP1 = Quirks{
+		Shift:              true,
+		MemoryIncrementByX: true,
+		Jump:               true,
+	}
+
+	//QuirksSCHIP is the SUPER-CHIP 1.1 interpreter
+	QuirksSCHIP = Quirks{
+		Shift:                 true,
+		MemoryLeaveIUnchanged: true,
+		Jump:                  true,
+	}
+
+	//QuirksXOCHIP is the XO-CHIP extension as implemented by Octo
+	QuirksXOCHIP = Quirks{
+		Wrap: true,
+	}
+
+	//Presets lists the quirks profiles by name
+	Presets = map[string]Quirks{
+		"vip":    QuirksVIP,
+		"chip48": QuirksCHIP48,
+		"schip1": QuirksSCHIP1,
+		"schip":  QuirksSCHIP,
+		"xochip": QuirksXOCHIP,
+	}
+)
@@ -0,0 +1,166 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+)
+
+//rewindHold pauses emulation after a rewind step so held keys rewind smoothly
+const rewindHold = 100 * time.Millisecond
+
+//Rewind is a ring buffer of the most recent save states.
+//Only the newest state is kept whole: every older one is stored as
+//the run-length encoded XOR against the state that followed it.
+type Rewind struct {
+	cur    []byte
+	deltas [][]byte
+	head   int
+	n      int
+	size   int
+	budget int
+}
+
+//NewRewind keeps up to frames states, using at most budget bytes for the deltas
+func NewRewind(frames, budget int) *Rewind {
+	return &Rewind{
+		deltas: make([][]byte, frames),
+		budget: budget,
+	}
+}
+
+//Push records the newest state
+func (r *Rewind) Push(state []byte) {
+
+	if r.cur != nil && len(r.cur) == len(state) {
+		if r.n == len(r.deltas) {
+			r.drop()
+		}
+		d := delta(r.cur, state)
+		r.deltas[(r.head+r.n)%len(r.deltas)] = d
+		r.n++
+		r.size += len(d)
+		for r.size > r.budget && r.n > 0 {
+			r.drop()
+		}
+	} else {
+		for i := range r.deltas {
+			r.deltas[i] = nil
+		}
+		r.head, r.n, r.size = 0, 0, 0
+	}
+	r.cur = state
+}
+
+//Pop discards the newest state and returns the one before it
+func (r *Rewind) Pop() ([]byte, bool) {
+
+	if r.n == 0 {
+		return nil, false
+	}
+	r.n--
+	i := (r.head + r.n) % len(r.deltas)
+	prev := undelta(r.cur, r.deltas[i])
+	r.size -= len(r.deltas[i])
+	r.deltas[i] = nil
+	r.cur = prev
+	return prev, true
+}
+
+//Len is the number of states that can be popped
+func (r *Rewind) Len() int {
+	return r.n
+}
+
+func (r *Rewind) drop() {
+	r.size -= len(r.deltas[r.head])
+	r.deltas[r.head] = nil
+	r.head = (r.head + 1) % len(r.deltas)
+	r.n--
+}
+
+//delta encodes prev XOR cur as pairs of uvarint (zeros skipped, literal count)
+//followed by the literal bytes
+func delta(prev, cur []byte) []byte {
+
+	var out []byte
+	var buf [binary.MaxVarintLen64]byte
+	i := 0
+	for i < len(cur) {
+		start := i
+		for i < len(cur) && prev[i] == cur[i] {
+			i++
+		}
+		skip := i - start
+		start = i
+		for i < len(cur) && prev[i] != cur[i] {
+			i++
+		}
+		out = append(out, buf[:binary.PutUvarint(buf[:], uint64(skip))]...)
+		out = append(out, buf[:binary.PutUvarint(buf[:], uint64(i-start))]...)
+		for j := start; j < i; j++ {
+			out = append(out, prev[j]^cur[j])
+		}
+	}
+	return out
+}
+
+//undelta applies a delta to cur, giving back the state it was taken against
+func undelta(cur, d []byte) []byte {
+
+	prev := make([]byte, len(cur))
+	copy(prev, cur)
+
+	r := bytes.NewReader(d)
+	i := 0
+	for r.Len() > 0 {
+		skip, _ := binary.ReadUvarint(r)
+		n, _ := binary.ReadUvarint(r)
+		i += int(skip)
+		for j := 0; j < int(n); j++ {
+			b, _ := r.ReadByte()
+			prev[i] ^= b
+			i++
+		}
+	}
+	return prev
+}
+
+//EnableRewind records a state every frame, keeping up to seconds of them in budget bytes
+func (c *Chip8) EnableRewind(seconds, budget int) {
+	c.rewind = NewRewind(seconds*timerFreq, budget)
+}
+
+//StepBack rewinds the game by the given number of frames
+func (c *Chip8) StepBack(frames int) {
+
+	c.Do(func() {
+		if c.rewind == nil {
+			return
+		}
+		var state []byte
+		for i := 0; i < frames; i++ {
+			s, ok := c.rewind.Pop()
+			if !ok {
+				break
+			}
+			state = s
+		}
+		if state != nil {
+			c.LoadState(bytes.NewReader(state))
+		}
+		c.rewindUntil = c.clock.Now().Add(rewindHold)
+	})
+}
+
+//record pushes the current state to the rewind buffer
+func (c *Chip8) record() {
+
+	if c.clock.Now().Before(c.rewindUntil) {
+		return
+	}
+	var b bytes.Buffer
+	if err := c.SaveState(&b); err == nil {
+		c.rewind.Push(b.Bytes())
+	}
+}
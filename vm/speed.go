@@ -0,0 +1,72 @@
+package vm
+
+import "time"
+
+//Unthrottled is the speed running frames back to back, as fast as they go
+const Unthrottled = 0
+
+//always is a closed channel, ready at once, that Loop runs frames on when unthrottled,
+//still taking the ticks of the clock to present the display
+var always = func() chan time.Time {
+	ch := make(chan time.Time)
+	close(ch)
+	return ch
+}()
+
+//SetSpeed runs the machine at speed times real time: 0.5 for slow motion, 2 to fast-forward,
+//or Unthrottled. The display is still presented at most 60 times a second.
+func (c *Chip8) SetSpeed(speed float64) {
+	c.Do(func() {
+		if speed < 0 {
+			speed = Unthrottled
+		}
+		c.speed = speed
+		//a ticker started ahead of Loop ticks at the new period from now
+		if c.ticker != nil {
+			c.retick()
+		}
+		c.report()
+	})
+}
+
+//FrameAdvance runs the rest of the current frame while paused, then shows the display
+func (c *Chip8) FrameAdvance() {
+	c.Do(func() {
+		if !c.paused {
+			return
+		}
+		c.reason = ""
+		c.resumed = true
+		c.err = c.advance(c.perFrame-c.frameCycle, true)
+		c.resumed = false
+		if c.rewind != nil && c.frameCycle == 0 {
+			c.record()
+		}
+		c.present()
+		c.report()
+	})
+}
+
+//Suspend stops running frames until Continue, whether the debugger pauses the machine or not.
+//The display is still presented when it changes, by loading a state for one.
+func (c *Chip8) Suspend() {
+	c.Do(func() {
+		c.suspended = true
+	})
+}
+
+//Continue runs the frames Suspend stopped
+func (c *Chip8) Continue() {
+	c.Do(func() {
+		c.suspended = false
+	})
+}
+
+//period is how long a frame lasts at the speed set, that of normal speed when unthrottled
+func (c *Chip8) period() time.Duration {
+
+	if c.speed == Unthrottled {
+		return timerDuration
+	}
+	return time.Duration(float64(timerDuration) / c.speed)
+}
@@ -0,0 +1,163 @@
+package vm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+//stateVersion is bumped whenever the save state layout changes
+const stateVersion = 1
+
+var (
+	stateMagic = [4]byte{'C', '8', 'S', 'T'}
+
+	errStateMagic = errors.New("not a save state")
+	//errStopped is returned for commands given once Loop returned
+	errStopped = errors.New("the machine has stopped")
+)
+
+//registers is the fixed size part of a save state
+type registers struct {
+	Register   [16]byte
+	Index      uint16
+	PC         uint16
+	Stack      [16]uint16
+	SP         uint16
+	DelayTimer byte
+	SoundTimer byte
+	RPL        [16]byte
+	Plane      byte
+	Pattern    [16]byte
+	Pitch      byte
+}
+
+//SaveState writes the whole machine, including the framebuffer, to w.
+//The layout is the magic "C8ST", a version byte, the registers,
+//then the memory and the framebuffer, each prefixed by its uint32 length.
+func (c *Chip8) SaveState(w io.Writer) error {
+
+	regs := registers{
+		Register:   c.register,
+		Index:      c.index,
+		PC:         c.pc,
+		Stack:      c.stack,
+		SP:         c.sp,
+		DelayTimer: c.delayTimer,
+		SoundTimer: c.soundTimer,
+		RPL:        c.rpl,
+		Plane:      c.plane,
+		Pattern:    c.pattern,
+		Pitch:      c.pitch,
+	}
+
+	for _, v := range []interface{}{stateMagic, byte(stateVersion), regs} {
+		if err := binary.Write(w, binary.BigEndian, v); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range [][]byte{c.mem, c.display.snapshot()} {
+		if err := binary.Write(w, binary.BigEndian, uint32(len(b))); err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//LoadState restores a machine written by SaveState
+func (c *Chip8) LoadState(r io.Reader) error {
+
+	var magic [4]byte
+	var version byte
+	var regs registers
+
+	if err := binary.Read(r, binary.BigEndian, &magic); err != nil {
+		return err
+	}
+	if magic != stateMagic {
+		return errStateMagic
+	}
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return err
+	}
+	if version != stateVersion {
+		return fmt.Errorf("unsupported save state version %d", version)
+	}
+	if err := binary.Read(r, binary.BigEndian, &regs); err != nil {
+		return err
+	}
+	//a corrupt state must fail here rather than at the next CALL, RET or DXYN
+	if int(regs.SP) > len(regs.Stack) {
+		return fmt.Errorf("bad save state stack pointer %d", regs.SP)
+	}
+	if regs.Plane > 3 {
+		return fmt.Errorf("bad save state plane %d", regs.Plane)
+	}
+
+	var bufs [2][]byte
+	for i := range bufs {
+		var n uint32
+		if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+			return err
+		}
+		if n > memSize {
+			return fmt.Errorf("save state section of %d bytes is too large", n)
+		}
+		bufs[i] = make([]byte, n)
+		if _, err := io.ReadFull(r, bufs[i]); err != nil {
+			return err
+		}
+	}
+	if len(bufs[0]) != len(c.mem) {
+		return fmt.Errorf("save state has %d bytes of memory, want %d", len(bufs[0]), len(c.mem))
+	}
+	if err := c.display.restore(bufs[1]); err != nil {
+		return err
+	}
+
+	copy(c.mem, bufs[0])
+	c.register = regs.Register
+	c.index = regs.Index
+	c.pc = regs.PC
+	c.stack = regs.Stack
+	c.sp = regs.SP
+	c.delayTimer = regs.DelayTimer
+	c.soundTimer = regs.SoundTimer
+	c.rpl = regs.RPL
+	c.plane = regs.Plane
+	c.pattern = regs.Pattern
+	c.pitch = regs.Pitch
+	c.vblank, c.keyWait, c.keyDown = false, false, false
+
+	return nil
+}
+
+//Do runs f on the emulation goroutine between two frames, waiting for Loop to take it.
+//Before Loop runs, f runs at once instead, so the machine can be set up with the same calls.
+//Do reports false if f was dropped, Loop having returned.
+func (c *Chip8) Do(f func()) bool {
+
+	c.loopMu.Lock()
+	looping, done := c.looping, c.done
+	c.loopMu.Unlock()
+
+	if !looping {
+		if done != nil {
+			return false
+		}
+		f()
+		return true
+	}
+	select {
+	case c.cmd <- f:
+		return true
+	case <-done:
+		return false
+	}
+}